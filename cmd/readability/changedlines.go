@@ -0,0 +1,38 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// analyzeChangedLines analyzes only the lines added under path since
+// the base ref, via git diff. Results carry the same joined paths the
+// normal walk would produce, so relativization and gating downstream
+// work unchanged.
+func analyzeChangedLines(a *analyzer.Analyzer, path, base string) ([]*analyzer.Result, error) {
+	dir := path
+	spec := "."
+	if info, err := os.Stat(path); err != nil {
+		return nil, err
+	} else if !info.IsDir() {
+		dir = filepath.Dir(path)
+		spec = filepath.Base(path)
+	}
+	// --relative keys the diff on paths under dir, matching what a
+	// directory walk of path would report; --unified=0 drops context
+	// lines so only genuinely added text is scored.
+	diff, err := gitOutput(dir, "diff", "--no-color", "--unified=0", "--relative", base, "--", spec)
+	if err != nil {
+		return nil, err
+	}
+	results, err := a.AnalyzeDiff([]byte(diff))
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range results {
+		r.Path = filepath.Join(dir, r.Path)
+	}
+	return results, nil
+}
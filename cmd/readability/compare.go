@@ -0,0 +1,341 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+var compareCmd = &cobra.Command{
+	Use:   "compare <before.json> <after.json>",
+	Short: "Compare two runs and gate on aggregate drift",
+	Long: `Compare two saved runs (the JSON array --format json writes) and
+report per-file grade changes plus corpus-wide aggregates.
+
+Per-file thresholds catch a single page going bad; this catches slow,
+broad erosion — a change that nudges many files worse without any one
+of them crossing a limit. Gate on it in CI with --max-regressions and
+--max-avg-grade-increase; --max-word-drop-percent additionally guards
+against mass content loss from a bad merge or script. A breached gate
+exits 1.`,
+	Args: cobra.ExactArgs(2),
+	RunE: runCompare,
+}
+
+var (
+	maxRegressions      int
+	maxAvgGradeIncrease float64
+	maxWordDropPercent  float64
+	diffOutput          string
+)
+
+func init() {
+	compareCmd.Flags().IntVar(&maxRegressions, "max-regressions", -1,
+		"Fail when more than this many files got a worse grade (-1 disables)")
+	compareCmd.Flags().Float64Var(&maxAvgGradeIncrease, "max-avg-grade-increase", 0,
+		"Fail when the corpus average grade rose by more than this")
+	compareCmd.Flags().Float64Var(&maxWordDropPercent, "max-word-drop-percent", 0,
+		"Fail when total corpus word count dropped by more than this percentage; catches mass deletions")
+	compareCmd.Flags().StringVar(&diffOutput, "diff-output", "",
+		"Also write a JSON delta report (per-file before/after metrics and aggregates) to this file")
+	rootCmd.AddCommand(compareCmd)
+}
+
+// gradeEps is the grade delta below which a file counts as unchanged,
+// absorbing float noise from re-analysis of identical content.
+const gradeEps = 0.05
+
+// fileDelta is one file's movement between two runs.
+type fileDelta struct {
+	Path   string
+	Before float64
+	After  float64
+}
+
+// comparison holds everything compare derives from two runs; the
+// gates and the report both read from it.
+type comparison struct {
+	Regressed []fileDelta
+	Improved  []fileDelta
+	Added     []string
+	Removed   []string
+
+	AvgBefore float64
+	AvgAfter  float64
+
+	WordsBefore int
+	WordsAfter  int
+}
+
+func runCompare(cmd *cobra.Command, args []string) error {
+	before, err := loadResults(args[0])
+	if err != nil {
+		return err
+	}
+	after, err := loadResults(args[1])
+	if err != nil {
+		return err
+	}
+	cmp := compareRuns(before, after)
+
+	if diffOutput != "" {
+		if err := writeDiffOutput(diffOutput, before, after, cmp); err != nil {
+			return err
+		}
+	}
+
+	w := cmd.OutOrStdout()
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	for _, d := range cmp.Regressed {
+		fmt.Fprintf(tw, "%s\tgrade %.1f -> %.1f\t(+%.1f)\n", d.Path, d.Before, d.After, d.After-d.Before)
+	}
+	for _, d := range cmp.Improved {
+		fmt.Fprintf(tw, "%s\tgrade %.1f -> %.1f\t(%.1f)\n", d.Path, d.Before, d.After, d.After-d.Before)
+	}
+	for _, p := range cmp.Added {
+		fmt.Fprintf(tw, "%s\tadded\n", p)
+	}
+	for _, p := range cmp.Removed {
+		fmt.Fprintf(tw, "%s\tremoved\n", p)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "\n%d regressed, %d improved, %d added, %d removed\n",
+		len(cmp.Regressed), len(cmp.Improved), len(cmp.Added), len(cmp.Removed))
+	fmt.Fprintf(w, "average grade %.2f -> %.2f (%+.2f)\n",
+		cmp.AvgBefore, cmp.AvgAfter, cmp.AvgAfter-cmp.AvgBefore)
+	fmt.Fprintf(w, "total words %d -> %d (%+d)\n",
+		cmp.WordsBefore, cmp.WordsAfter, cmp.WordsAfter-cmp.WordsBefore)
+
+	failed := false
+	if maxRegressions >= 0 && len(cmp.Regressed) > maxRegressions {
+		fmt.Fprintf(w, "FAIL: %d regressed files exceeds limit %d\n", len(cmp.Regressed), maxRegressions)
+		failed = true
+	}
+	if cmd.Flags().Changed("max-avg-grade-increase") && cmp.AvgAfter-cmp.AvgBefore > maxAvgGradeIncrease {
+		fmt.Fprintf(w, "FAIL: average grade rose %.2f, more than limit %.2f\n",
+			cmp.AvgAfter-cmp.AvgBefore, maxAvgGradeIncrease)
+		failed = true
+	}
+	if cmd.Flags().Changed("max-word-drop-percent") && cmp.wordDropPercent() > maxWordDropPercent {
+		fmt.Fprintf(w, "FAIL: total word count dropped %.1f%%, more than limit %.1f%% — possible mass deletion\n",
+			cmp.wordDropPercent(), maxWordDropPercent)
+		failed = true
+	}
+	if failed {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// compareRuns matches results by path and buckets each file as
+// regressed, improved, added, or removed. Skipped and errored entries
+// are left out on both sides; they carry no scores to compare.
+func compareRuns(before, after []*analyzer.Result) comparison {
+	prev := make(map[string]*analyzer.Result)
+	for _, r := range scored(before) {
+		prev[r.Path] = r
+	}
+	var cmp comparison
+	seen := make(map[string]bool)
+	for _, r := range scored(after) {
+		seen[r.Path] = true
+		old, ok := prev[r.Path]
+		if !ok {
+			cmp.Added = append(cmp.Added, r.Path)
+			continue
+		}
+		d := fileDelta{Path: r.Path, Before: old.Metrics.FleschKincaid, After: r.Metrics.FleschKincaid}
+		switch {
+		case d.After-d.Before > gradeEps:
+			cmp.Regressed = append(cmp.Regressed, d)
+		case d.Before-d.After > gradeEps:
+			cmp.Improved = append(cmp.Improved, d)
+		}
+	}
+	for path := range prev {
+		if !seen[path] {
+			cmp.Removed = append(cmp.Removed, path)
+		}
+	}
+	sort.Slice(cmp.Regressed, func(i, j int) bool { return cmp.Regressed[i].Path < cmp.Regressed[j].Path })
+	sort.Slice(cmp.Improved, func(i, j int) bool { return cmp.Improved[i].Path < cmp.Improved[j].Path })
+	sort.Strings(cmp.Added)
+	sort.Strings(cmp.Removed)
+	cmp.AvgBefore = avgGrade(before)
+	cmp.AvgAfter = avgGrade(after)
+	cmp.WordsBefore = totalWords(before)
+	cmp.WordsAfter = totalWords(after)
+	return cmp
+}
+
+// wordDropPercent is how far total word count fell between the runs,
+// as a percentage of the before count; zero when it grew or the before
+// run was empty.
+func (c comparison) wordDropPercent() float64 {
+	if c.WordsBefore == 0 || c.WordsAfter >= c.WordsBefore {
+		return 0
+	}
+	return float64(c.WordsBefore-c.WordsAfter) / float64(c.WordsBefore) * 100
+}
+
+// scored filters a run down to entries with metrics worth comparing.
+func scored(results []*analyzer.Result) []*analyzer.Result {
+	out := results[:0:0]
+	for _, r := range results {
+		if r.Status == analyzer.StatusSkipped || r.Status == analyzer.StatusError {
+			continue
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// totalWords sums word counts across a run's scored files.
+func totalWords(results []*analyzer.Result) int {
+	total := 0
+	for _, r := range scored(results) {
+		total += r.Metrics.Words
+	}
+	return total
+}
+
+// avgGrade is the mean flesch-kincaid grade across a run's scored files.
+func avgGrade(results []*analyzer.Result) float64 {
+	sum, n := 0.0, 0
+	for _, r := range scored(results) {
+		sum += r.Metrics.FleschKincaid
+		n++
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / float64(n)
+}
+
+// diffFile is one file's entry in the --diff-output report: its
+// classification plus full before and after metrics, nil on the side
+// where the file does not exist.
+type diffFile struct {
+	Path       string            `json:"path"`
+	Change     string            `json:"change"`
+	Before     *analyzer.Metrics `json:"before,omitempty"`
+	After      *analyzer.Metrics `json:"after,omitempty"`
+	GradeDelta float64           `json:"grade_delta"`
+}
+
+// diffReport is the --diff-output document: every compared file with
+// per-metric before/after values, and the aggregate movement, so
+// dashboards consume deltas without scraping the table or recomputing
+// classifications.
+type diffReport struct {
+	Files     []diffFile `json:"files"`
+	Aggregate struct {
+		AvgGradeBefore float64 `json:"avg_grade_before"`
+		AvgGradeAfter  float64 `json:"avg_grade_after"`
+		AvgGradeDelta  float64 `json:"avg_grade_delta"`
+		Regressed      int     `json:"regressed"`
+		Improved       int     `json:"improved"`
+		Added          int     `json:"added"`
+		Removed        int     `json:"removed"`
+		Unchanged      int     `json:"unchanged"`
+
+		TotalWordsBefore int     `json:"total_words_before"`
+		TotalWordsAfter  int     `json:"total_words_after"`
+		WordDropPercent  float64 `json:"word_drop_percent"`
+	} `json:"aggregate"`
+}
+
+// writeDiffOutput writes the structured delta report to path.
+func writeDiffOutput(path string, before, after []*analyzer.Result, cmp comparison) error {
+	prev := make(map[string]*analyzer.Result)
+	for _, r := range scored(before) {
+		prev[r.Path] = r
+	}
+	next := make(map[string]*analyzer.Result)
+	for _, r := range scored(after) {
+		next[r.Path] = r
+	}
+	change := make(map[string]string)
+	for _, d := range cmp.Regressed {
+		change[d.Path] = "regressed"
+	}
+	for _, d := range cmp.Improved {
+		change[d.Path] = "improved"
+	}
+	for _, p := range cmp.Added {
+		change[p] = "added"
+	}
+	for _, p := range cmp.Removed {
+		change[p] = "removed"
+	}
+
+	paths := make([]string, 0, len(prev)+len(next))
+	for p := range prev {
+		paths = append(paths, p)
+	}
+	for p := range next {
+		if _, ok := prev[p]; !ok {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	var report diffReport
+	report.Files = make([]diffFile, 0, len(paths))
+	for _, p := range paths {
+		f := diffFile{Path: p, Change: change[p]}
+		if f.Change == "" {
+			f.Change = "unchanged"
+			report.Aggregate.Unchanged++
+		}
+		if r, ok := prev[p]; ok {
+			m := r.Metrics
+			f.Before = &m
+		}
+		if r, ok := next[p]; ok {
+			m := r.Metrics
+			f.After = &m
+		}
+		if f.Before != nil && f.After != nil {
+			f.GradeDelta = f.After.FleschKincaid - f.Before.FleschKincaid
+		}
+		report.Files = append(report.Files, f)
+	}
+	report.Aggregate.AvgGradeBefore = cmp.AvgBefore
+	report.Aggregate.AvgGradeAfter = cmp.AvgAfter
+	report.Aggregate.AvgGradeDelta = cmp.AvgAfter - cmp.AvgBefore
+	report.Aggregate.Regressed = len(cmp.Regressed)
+	report.Aggregate.Improved = len(cmp.Improved)
+	report.Aggregate.Added = len(cmp.Added)
+	report.Aggregate.Removed = len(cmp.Removed)
+	report.Aggregate.TotalWordsBefore = cmp.WordsBefore
+	report.Aggregate.TotalWordsAfter = cmp.WordsAfter
+	report.Aggregate.WordDropPercent = cmp.wordDropPercent()
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// loadResults reads a saved run: the JSON array --format json writes.
+func loadResults(path string) ([]*analyzer.Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var results []*analyzer.Result
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("%s: not a saved run (expected the --format json array): %w", path, err)
+	}
+	return results, nil
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// scoredResult builds the minimal result compare works from.
+func scoredResult(path string, grade float64, words int) *analyzer.Result {
+	return &analyzer.Result{Path: path, Status: analyzer.StatusPass,
+		Metrics: analyzer.Metrics{FleschKincaid: grade, Words: words}}
+}
+
+func TestCompareRunsClassification(t *testing.T) {
+	before := []*analyzer.Result{
+		scoredResult("better.md", 10, 100),
+		scoredResult("gone.md", 10, 100),
+		scoredResult("noise.md", 10, 100),
+		scoredResult("same.md", 10, 100),
+		scoredResult("worse.md", 10, 100),
+		{Path: "skipped.md", Status: analyzer.StatusSkipped},
+	}
+	after := []*analyzer.Result{
+		scoredResult("better.md", 9.5, 100),
+		scoredResult("new.md", 8, 50),
+		scoredResult("noise.md", 10.04, 100), // within gradeEps: unchanged
+		scoredResult("same.md", 10, 100),
+		scoredResult("worse.md", 10.2, 100),
+		{Path: "skipped.md", Status: analyzer.StatusSkipped},
+	}
+
+	cmp := compareRuns(before, after)
+	if len(cmp.Regressed) != 1 || cmp.Regressed[0].Path != "worse.md" {
+		t.Errorf("Regressed = %+v, want worse.md only", cmp.Regressed)
+	}
+	if len(cmp.Improved) != 1 || cmp.Improved[0].Path != "better.md" {
+		t.Errorf("Improved = %+v, want better.md only", cmp.Improved)
+	}
+	// skipped.md carries no scores on either side, so it must appear
+	// neither as added nor as removed.
+	if len(cmp.Added) != 1 || cmp.Added[0] != "new.md" {
+		t.Errorf("Added = %v, want new.md only", cmp.Added)
+	}
+	if len(cmp.Removed) != 1 || cmp.Removed[0] != "gone.md" {
+		t.Errorf("Removed = %v, want gone.md only", cmp.Removed)
+	}
+}
+
+func TestCompareRunsAggregates(t *testing.T) {
+	before := []*analyzer.Result{
+		scoredResult("a.md", 10, 600),
+		scoredResult("b.md", 14, 400),
+	}
+	after := []*analyzer.Result{
+		scoredResult("a.md", 11, 500),
+		scoredResult("b.md", 13, 400),
+	}
+	cmp := compareRuns(before, after)
+	if cmp.AvgBefore != 12 || cmp.AvgAfter != 12 {
+		t.Errorf("averages = %.2f -> %.2f, want 12 -> 12", cmp.AvgBefore, cmp.AvgAfter)
+	}
+	if cmp.WordsBefore != 1000 || cmp.WordsAfter != 900 {
+		t.Errorf("words = %d -> %d, want 1000 -> 900", cmp.WordsBefore, cmp.WordsAfter)
+	}
+	if got := cmp.wordDropPercent(); got != 10 {
+		t.Errorf("wordDropPercent = %.1f, want 10", got)
+	}
+}
+
+func TestWordDropPercentEdges(t *testing.T) {
+	if got := (comparison{WordsBefore: 100, WordsAfter: 120}).wordDropPercent(); got != 0 {
+		t.Errorf("growth reported a drop of %.1f%%", got)
+	}
+	if got := (comparison{WordsBefore: 0, WordsAfter: 0}).wordDropPercent(); got != 0 {
+		t.Errorf("empty before run reported a drop of %.1f%%", got)
+	}
+}
+
+func TestWriteDiffOutput(t *testing.T) {
+	before := []*analyzer.Result{
+		scoredResult("gone.md", 9, 50),
+		scoredResult("same.md", 10, 100),
+		scoredResult("worse.md", 10, 100),
+	}
+	after := []*analyzer.Result{
+		scoredResult("new.md", 8, 50),
+		scoredResult("same.md", 10, 100),
+		scoredResult("worse.md", 10.2, 100),
+	}
+	path := filepath.Join(t.TempDir(), "diff.json")
+	if err := writeDiffOutput(path, before, after, compareRuns(before, after)); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report diffReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		t.Fatal(err)
+	}
+
+	change := map[string]string{}
+	for _, f := range report.Files {
+		change[f.Path] = f.Change
+	}
+	want := map[string]string{
+		"gone.md": "removed", "new.md": "added",
+		"same.md": "unchanged", "worse.md": "regressed",
+	}
+	for p, c := range want {
+		if change[p] != c {
+			t.Errorf("%s classified %q, want %q", p, change[p], c)
+		}
+	}
+	for _, f := range report.Files {
+		switch f.Path {
+		case "gone.md":
+			if f.Before == nil || f.After != nil {
+				t.Errorf("gone.md sides = (%v, %v), want before only", f.Before, f.After)
+			}
+		case "new.md":
+			if f.Before != nil || f.After == nil {
+				t.Errorf("new.md sides = (%v, %v), want after only", f.Before, f.After)
+			}
+		case "worse.md":
+			if math.Abs(f.GradeDelta-0.2) > 1e-9 {
+				t.Errorf("worse.md grade delta = %v, want 0.2", f.GradeDelta)
+			}
+		}
+	}
+
+	agg := report.Aggregate
+	if agg.Regressed != 1 || agg.Improved != 0 || agg.Added != 1 || agg.Removed != 1 || agg.Unchanged != 1 {
+		t.Errorf("aggregate counts = %+v, want 1 regressed, 0 improved, 1 added, 1 removed, 1 unchanged", agg)
+	}
+	if agg.TotalWordsBefore != 250 || agg.TotalWordsAfter != 250 || agg.WordDropPercent != 0 {
+		t.Errorf("aggregate words = %d -> %d (%.1f%% drop), want 250 -> 250 (0%%)",
+			agg.TotalWordsBefore, agg.TotalWordsAfter, agg.WordDropPercent)
+	}
+}
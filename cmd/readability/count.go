@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// printCount reports the totals from a --count-only run. The skipped
+// line only appears when excludes actually removed something, so its
+// presence is itself the sanity check.
+func printCount(w io.Writer, c analyzer.CorpusCount) {
+	fmt.Fprintf(w, "%d files, %d words, %d lines\n", c.Files, c.Words, c.Lines)
+	if c.Skipped > 0 {
+		fmt.Fprintf(w, "%d files skipped by excludes\n", c.Skipped)
+	}
+}
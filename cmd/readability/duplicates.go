@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// printDuplicates reports near-duplicate clusters, most similar first.
+// Paths are shown relative to the analyzed directory like the main
+// report.
+func printDuplicates(w io.Writer, clusters []analyzer.DuplicateCluster, base string) {
+	if len(clusters) == 0 {
+		fmt.Fprintln(w, "no duplicate clusters found")
+		return
+	}
+	fmt.Fprintf(w, "%d duplicate clusters:\n", len(clusters))
+	for _, c := range clusters {
+		fmt.Fprintf(w, "  ~%.0f%% similar:\n", c.Similarity*100)
+		for _, f := range c.Files {
+			if rel, err := filepath.Rel(base, f); err == nil {
+				f = filepath.ToSlash(rel)
+			}
+			fmt.Fprintf(w, "    %s\n", f)
+		}
+	}
+}
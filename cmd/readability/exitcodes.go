@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+// Category exit-code bits for --category-exit-codes. They combine as a
+// bitmask — readability plus structure failures exit 12 — and never
+// collide with the plain codes 1 (generic failure) and 2 (error), so
+// wrapper scripts can branch on (code & bit) per category.
+const (
+	exitReadability = 4  // formula thresholds: grade, ARI, fog, ease, sentence length
+	exitStructure   = 8  // size and shape: lines, lists, headings, tables, code
+	exitStyle       = 16 // editorial checks: spelling, whitespace, terminology
+)
+
+// checkCategory maps each check name to its exit-code bit. Unlisted
+// checks (registered plugins, future thresholds) count as structure,
+// the broadest category.
+var checkCategory = map[string]int{
+	"max_grade":          exitReadability,
+	"max_ari":            exitReadability,
+	"max_fog":            exitReadability,
+	"min_ease":           exitReadability,
+	"max_coleman_liau":   exitReadability,
+	"max_smog":           exitReadability,
+	"max_sentence_words": exitReadability,
+	"max_metric_spread":  exitReadability,
+
+	"max_first_paragraph_grade": exitReadability,
+
+	"max_lines":                   exitStructure,
+	"max_scroll_weight":           exitStructure,
+	"max_list_depth":              exitStructure,
+	"min_heading_density":         exitStructure,
+	"max_code_ratio":              exitStructure,
+	"min_prose_words":             exitStructure,
+	"max_consecutive_code_blocks": exitStructure,
+	"max_heading_words":           exitStructure,
+	"max_file_bytes":              exitStructure,
+	"fail_on_empty_sections":      exitStructure,
+	"fail_on_heading_style":       exitStructure,
+	"fail_on_mixed_list_markers":  exitStructure,
+	"fail_on_broken_tables":       exitStructure,
+	"fail_on_broken_admonitions":  exitStructure,
+	"fail_on_prose_in_code":       exitStructure,
+	"fail_on_unlabeled_code":      exitStructure,
+	"fail_on_markdown_syntax":     exitStructure,
+	"require_h1":                  exitStructure,
+
+	"fail_on_spelling":          exitStyle,
+	"fail_on_spelling_variants": exitStyle,
+	"fail_on_whitespace":        exitStyle,
+	"fail_on_repeated_openings": exitStyle,
+	"fail_on_terminology":       exitStyle,
+	"fail_on_bare_urls":         exitStyle,
+}
+
+// categoryBits folds a run's failures into the exit-code bitmask.
+// Warnings count only under --strict-warnings, mirroring the plain
+// --check behavior.
+func categoryBits(results []*analyzer.Result, strictWarnings bool) int {
+	code := 0
+	for _, r := range results {
+		for _, f := range r.Failures {
+			if f.Severity == config.SeverityInfo {
+				continue
+			}
+			if f.Severity == config.SeverityWarning && !strictWarnings {
+				continue
+			}
+			bit, ok := checkCategory[f.Check]
+			if !ok {
+				bit = exitStructure
+			}
+			code |= bit
+		}
+	}
+	return code
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// explanation pairs a plain-language description of a check with
+// concrete advice for bringing a file back under its limit.
+type explanation struct {
+	what string
+	fix  string
+}
+
+// explanations maps check names (see analyzer.Evaluate) to guidance a
+// writer can act on without knowing the formulas.
+var explanations = map[string]explanation{
+	"max_grade": {
+		"Flesch-Kincaid grade estimates the US school grade needed to follow the text; long sentences and long words push it up.",
+		"Split sentences over ~25 words and swap multi-syllable words for shorter ones (\"use\" over \"utilize\").",
+	},
+	"max_ari": {
+		"ARI estimates grade level from characters per word and words per sentence, so it punishes long words even when they are familiar.",
+		"Shorten sentences and prefer short, concrete words; expand dense noun chains into clauses.",
+	},
+	"max_fog": {
+		"Gunning Fog rises with sentence length and the share of words with three or more syllables.",
+		"Break up long sentences and replace complex words; one idea per sentence.",
+	},
+	"min_ease": {
+		"Flesch Reading Ease scores 0-100; higher is easier. Long sentences and syllable-heavy words drag it down.",
+		"Aim for shorter sentences, everyday vocabulary, and active voice.",
+	},
+	"max_coleman_liau": {
+		"Coleman-Liau estimates grade level from letters per word and sentences per hundred words.",
+		"Use shorter words and more sentence breaks; abbreviate only where the abbreviation is well known.",
+	},
+	"max_smog": {
+		"SMOG estimates the grade needed from the density of three-plus-syllable words.",
+		"Hunt down polysyllabic words and replace or define them; jargon counts against you.",
+	},
+	"max_file_bytes": {
+		"The file is larger than the size guard allows, so it was never read or scored.",
+		"Check whether a generated artifact was committed by accident; split or remove it.",
+	},
+	"max_lines": {
+		"The file is longer than the configured line limit; very long pages are hard to navigate and review.",
+		"Split the page into focused sub-pages, or move reference tables and long examples elsewhere.",
+	},
+	"max_scroll_weight": {
+		"The page's estimated rendered length — lines plus a per-image and per-code-block cost — exceeds the cap; scrolling it is exhausting even if the raw line count looks fine.",
+		"Split the page, trim screenshots, or consolidate stacked code blocks; images weigh far more than their single source line.",
+	},
+	"max_code_ratio": {
+		"Code blocks outweigh prose beyond the configured ratio; readers get examples without enough narration.",
+		"Add prose explaining what each example does, or trim boilerplate from the code blocks.",
+	},
+	"max_list_depth": {
+		"Lists are nested deeper than the configured limit; deep nesting is hard to scan.",
+		"Flatten the structure: promote nested items to headings or split them into separate lists.",
+	},
+	"min_heading_density": {
+		"Long stretches of the file run without an H2 heading, leaving readers without waypoints.",
+		"Add section headings so each screenful of text has a signpost.",
+	},
+	"min_prose_words": {
+		"The file has less prose than the configured floor; stub pages frustrate readers.",
+		"Flesh the page out, or fold it into a related page if there is nothing more to say.",
+	},
+	"max_sentence_words": {
+		"At least one sentence runs past the configured word cap; very long sentences lose readers regardless of vocabulary.",
+		"Split the listed sentences at their natural clause boundaries; each line number points at one.",
+	},
+	"fail_on_spelling": {
+		"Words were found that are in neither the embedded dictionary nor the project dictionary.",
+		"Fix genuine typos; add legitimate project terms to the dictionary file.",
+	},
+	"fail_on_spelling_variants": {
+		"The file spells the same words in both US and UK forms, or uses the dialect analysis.spelling_variant disallows.",
+		"Pick one dialect and apply it throughout; each flagged line names the word and the spelling the rest of the file (or the config) expects.",
+	},
+	"fail_on_whitespace": {
+		"The file has whitespace style violations: trailing spaces, hard tabs in prose, or doubled blank lines.",
+		"Clean them up, or let your editor strip trailing whitespace on save.",
+	},
+	"fail_on_empty_sections": {
+		"Some headings have no content beneath them before the next heading.",
+		"Write the missing sections or remove the placeholder headings.",
+	},
+	"fail_on_repeated_openings": {
+		"Several consecutive sentences start with the same word, which reads as monotonous.",
+		"Vary the sentence openings; restructure or combine repetitive sentences.",
+	},
+	"fail_on_broken_admonitions": {
+		"An admonition block or definition list is subtly malformed: a body indented fewer than four spaces, a missing type, or a definition with no term above it.",
+		"Fix the indentation or structure at each flagged line; these render silently wrong rather than erroring, so the source looks fine.",
+	},
+	"fail_on_broken_tables": {
+		"Some tables are malformed: a missing separator row or rows whose column count differs from the header.",
+		"Re-align the table so every row has the header's column count and a |---| separator sits under the header.",
+	},
+	"max_first_paragraph_grade": {
+		"The opening paragraph scores harder than its cap, and the opening is where readers decide whether to continue.",
+		"Rewrite the first paragraph in short, plain sentences that say what the page covers; push detail further down.",
+	},
+	"max_metric_spread": {
+		"The grade-level formulas disagree widely on this file, so no single score can be trusted; something in the content is skewing one of them.",
+		"Read the page yourself: look for unusual word lengths, missing punctuation, or fragments the sentence splitter misreads, and fix the source of the skew.",
+	},
+	"max_heading_words": {
+		"A heading runs past the word cap; full-sentence headings scan poorly and overflow tables of contents.",
+		"Shorten each flagged heading to a noun phrase; move the detail into the section's first sentence.",
+	},
+	"max_consecutive_code_blocks": {
+		"Several fenced code blocks stack with no prose between them, so readers get commands without context.",
+		"Add a sentence before each block saying what it does and what to expect, or merge related snippets.",
+	},
+	"fail_on_prose_in_code": {
+		"A fenced code block is mostly natural-language sentences, which usually means a closing fence is missing and prose got swallowed into the block.",
+		"Find the fence above the flagged line and close it where the code actually ends; the trapped prose then renders and gets analyzed normally.",
+	},
+	"fail_on_markdown_syntax": {
+		"The file contains markup that is not well-formed markdown: an unclosed fence or code span, unbalanced strong emphasis, or a reference link with no definition.",
+		"Fix the markup at each flagged line; renderers recover from these silently, so the page looks subtly wrong rather than erroring.",
+	},
+	"fail_on_unlabeled_code": {
+		"A fenced code block declares no language, so it renders without syntax highlighting.",
+		"Add the language after the opening fence (```bash, ```go); use ```text when the block is intentionally plain.",
+	},
+	"fail_on_bare_urls": {
+		"A raw https:// URL sits in prose without link syntax, which renders inconsistently and reads as noise.",
+		"Wrap each flagged URL in a link with descriptive text, like [the install guide](https://...), or use <...> autolink form where a visible address is the point.",
+	},
+	"fail_on_terminology": {
+		"The file uses terms the terminology rules disallow in favor of preferred forms.",
+		"Replace each flagged term with its preferred form; the line numbers point at every occurrence.",
+	},
+	"require_h1": {
+		"The file does not open with exactly one H1 title: it is missing, duplicated, or a deeper heading comes first.",
+		"Give the page a single # title as its first heading and demote any other H1s to H2.",
+	},
+	"fail_on_mixed_list_markers": {
+		"The file mixes list marker styles: bullets switch between -, *, and +, or ordered items between 1. and 1) forms.",
+		"Pick one marker per list kind and apply it throughout; the line numbers point at each deviation from the file's first.",
+	},
+	"fail_on_heading_style": {
+		"The file uses setext (underlined) headings or mixes heading styles; the style guide mandates ATX (#).",
+		"Convert underlined headings to the # form and keep one style throughout.",
+	},
+}
+
+// printExplanations walks failing files and prints, for each failing
+// check, what the metric means and how to fix it. Static text keyed by
+// check name: the goal is actionable guidance, not another number.
+func printExplanations(w io.Writer, results []*analyzer.Result) {
+	printed := false
+	for _, r := range results {
+		var lines []string
+		for _, f := range r.Failures {
+			e, ok := explanations[f.Check]
+			if !ok {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("  %s: %s\n    what: %s\n    fix:  %s",
+				f.Check, f.Message, e.what, e.fix))
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		if !printed {
+			fmt.Fprintln(w)
+			printed = true
+		}
+		fmt.Fprintf(w, "%s:\n", r.Path)
+		for _, l := range lines {
+			fmt.Fprintln(w, l)
+		}
+	}
+}
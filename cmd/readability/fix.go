@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// runFix rewrites mechanical issues in place under path and reports
+// what changed per file. Only markdown files are touched: the fixes
+// are markdown-shaped, and the other formats are usually generated.
+func runFix(w io.Writer, path string) error {
+	var files []string
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && strings.HasSuffix(p, ".md") {
+				files = append(files, p)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		files = []string{path}
+	}
+
+	fixedFiles := 0
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		out, counts := analyzer.Fix(src)
+		if len(counts) == 0 {
+			continue
+		}
+		st, err := os.Stat(file)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(file, out, st.Mode().Perm()); err != nil {
+			return err
+		}
+		fixedFiles++
+		kinds := make([]string, 0, len(counts))
+		for kind := range counts {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+		parts := make([]string, len(kinds))
+		for i, kind := range kinds {
+			parts[i] = fmt.Sprintf("%d %s", counts[kind], strings.ReplaceAll(kind, "_", " "))
+		}
+		fmt.Fprintf(w, "%s: fixed %s\n", file, strings.Join(parts, ", "))
+	}
+	fmt.Fprintf(w, "\n%d of %d files rewritten\n", fixedFiles, len(files))
+	return nil
+}
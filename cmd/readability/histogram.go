@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// printHistogram renders an ASCII bar histogram of the --sort metric
+// across the corpus, one row per bucket. Where the summary's
+// distribution table names reading-level bands, this shows the shape:
+// clustering, tails, and outliers are visible at a glance. Bars scale
+// to the terminal width; pipes get a fixed 80 columns.
+func printHistogram(w io.Writer, results []*analyzer.Result, metric string) error {
+	key, err := metricKey(metric)
+	if err != nil {
+		return err
+	}
+	var values []float64
+	for _, r := range results {
+		if r.Status == analyzer.StatusSkipped || r.Status == analyzer.StatusError {
+			continue
+		}
+		v := key(r)
+		if metric == "ease" {
+			// metricKey negates ease for worst-first sorting; the
+			// histogram wants the real scale back.
+			v = -v
+		}
+		values = append(values, v)
+	}
+	if len(values) == 0 {
+		fmt.Fprintln(w, "no scored files to bucket")
+		return nil
+	}
+
+	lo, hi := values[0], values[0]
+	for _, v := range values[1:] {
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	step := bucketStep(hi - lo)
+	lo = math.Floor(lo/step) * step
+	buckets := int(math.Floor((hi-lo)/step)) + 1
+	counts := make([]int, buckets)
+	for _, v := range values {
+		counts[int(math.Floor((v-lo)/step))]++
+	}
+	max := 0
+	for _, c := range counts {
+		if c > max {
+			max = c
+		}
+	}
+
+	// Bars fill whatever width remains after the range and count
+	// columns: "  12.0 - 14.0  <bar> 42".
+	width := 80
+	if cols, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && cols > 0 {
+		width = cols
+	}
+	barWidth := width - 24
+	if barWidth < 10 {
+		barWidth = 10
+	}
+
+	fmt.Fprintf(w, "\n%s distribution (%d files):\n", metric, len(values))
+	for i, c := range counts {
+		from := lo + float64(i)*step
+		bar := strings.Repeat("#", int(math.Round(float64(c)/float64(max)*float64(barWidth))))
+		fmt.Fprintf(w, "%6.1f - %-6.1f %-*s %d\n", from, from+step, barWidth, bar, c)
+	}
+	return nil
+}
+
+// bucketStep picks a readable bucket width for a value range from the
+// 1-2-5 progression, targeting about ten buckets.
+func bucketStep(span float64) float64 {
+	if span <= 0 {
+		return 1
+	}
+	raw := span / 10
+	mag := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if raw <= m*mag {
+			return m * mag
+		}
+	}
+	return 10 * mag
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+var historyLimit int
+
+var historyCmd = &cobra.Command{
+	Use:   "history <file>",
+	Short: "Show a file's readability trend over its git history",
+	Long: `Analyze each git revision of a file and print flesch-kincaid grade
+and word count by commit date, oldest first. Useful for spotting the
+commit where a page became harder to read.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHistory,
+}
+
+func init() {
+	historyCmd.Flags().IntVar(&historyLimit, "limit", 20, "Number of most recent commits to analyze")
+	rootCmd.AddCommand(historyCmd)
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	path := args[0]
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+	a := analyzer.New(cfg)
+	a.Log = newLogger(cmd.ErrOrStderr())
+
+	dir := filepath.Dir(path)
+	gitPath, err := gitOutput(dir, "ls-files", "--full-name", "--error-unmatch", filepath.Base(path))
+	if err != nil {
+		return fmt.Errorf("%s is not tracked by git", path)
+	}
+	gitPath = strings.TrimSpace(gitPath)
+
+	log, err := gitOutput(dir, "log", "-n", fmt.Sprint(historyLimit),
+		"--format=%h\t%cs", "--", filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	revs := strings.Split(strings.TrimSpace(log), "\n")
+	if len(revs) == 0 || revs[0] == "" {
+		return fmt.Errorf("%s has no git history", path)
+	}
+
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "date\tcommit\tgrade\twords")
+	for i := len(revs) - 1; i >= 0; i-- {
+		hash, date, _ := strings.Cut(revs[i], "\t")
+		src, err := gitOutput(dir, "show", hash+":"+gitPath)
+		if err != nil {
+			continue // file absent at this revision
+		}
+		res, err := a.Analyze(path, []byte(src))
+		if err != nil {
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.1f\t%d\n", date, hash, res.Metrics.FleschKincaid, res.Metrics.Words)
+	}
+	return tw.Flush()
+}
+
+// gitOutput runs a git command in dir and returns its stdout.
+func gitOutput(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %w", strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
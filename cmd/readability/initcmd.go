@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Scaffold a commented " + config.DefaultPath,
+	Long: `Write a commented ` + config.DefaultPath + ` with every threshold at its
+default and optional checks documented in place, so the configuration
+surface is discoverable without reading source.
+
+Respects --config for a different destination and refuses to overwrite
+an existing file unless --force is given.`,
+	Args: cobra.NoArgs,
+	RunE: runInit,
+}
+
+var initForce bool
+
+func init() {
+	initCmd.Flags().BoolVar(&initForce, "force", false, "Overwrite an existing config file")
+	rootCmd.AddCommand(initCmd)
+}
+
+func runInit(cmd *cobra.Command, args []string) error {
+	if !initForce {
+		if _, err := os.Stat(configPath); err == nil {
+			return fmt.Errorf("%s already exists; use --force to overwrite", configPath)
+		}
+	}
+	if err := os.WriteFile(configPath, []byte(config.Sample), 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "wrote %s\n", configPath)
+	return nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"io"
+	"log/slog"
+)
+
+// newLogger builds the stderr logger for the current verbosity: silent
+// by default, info at -v, debug at -vv. Logs never go to stdout, so
+// machine-readable output stays parseable.
+func newLogger(w io.Writer) *slog.Logger {
+	var level slog.Level
+	switch {
+	case verbosity >= 2:
+		level = slog.LevelDebug
+	case verbosity == 1:
+		level = slog.LevelInfo
+	default:
+		return slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{Level: level}))
+}
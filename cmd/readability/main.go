@@ -0,0 +1,15 @@
+// Command readability analyzes markdown documentation for readability
+// and structural quality, enforcing the thresholds in .readability.yml.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if err := rootCmd.Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+// printOrphans reports pages with no inbound internal links. Paths are
+// shown relative to the analyzed directory like the main report.
+func printOrphans(w io.Writer, orphans []string, base string) {
+	if len(orphans) == 0 {
+		fmt.Fprintln(w, "no orphan pages found")
+		return
+	}
+	fmt.Fprintf(w, "%d orphan pages (no inbound internal links):\n", len(orphans))
+	for _, o := range orphans {
+		if rel, err := filepath.Rel(base, o); err == nil {
+			o = filepath.ToSlash(rel)
+		}
+		fmt.Fprintf(w, "  %s\n", o)
+	}
+}
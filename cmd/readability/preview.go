@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+// previewThresholds reports what would change under hypothetical
+// threshold values, so enforcement ramps can be planned from data
+// instead of guesses. Each spec is "name=value", e.g. "max-grade=12".
+// Per-path overrides still apply; only the named threshold is replaced.
+func previewThresholds(w io.Writer, cfg *config.Config, results []*analyzer.Result, specs []string) error {
+	for _, spec := range specs {
+		name, raw, ok := strings.Cut(spec, "=")
+		if !ok {
+			return fmt.Errorf("preview threshold %q: want name=value", spec)
+		}
+		value, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return fmt.Errorf("preview threshold %q: %w", spec, err)
+		}
+
+		fmt.Fprintf(w, "\npreview %s=%v:\n", name, value)
+		current, would := 0, 0
+		for _, r := range results {
+			if r.Status == analyzer.StatusSkipped {
+				continue
+			}
+			thresholds, _ := cfg.ForPath(r.Path)
+			if err := thresholds.Set(name, value); err != nil {
+				return fmt.Errorf("preview threshold %q: %w", spec, err)
+			}
+
+			wouldFail := len(analyzer.Evaluate(r, thresholds)) > 0
+			nowFails := r.Status == analyzer.StatusFail
+			if nowFails {
+				current++
+			}
+			if wouldFail {
+				would++
+			}
+			if wouldFail != nowFails {
+				fmt.Fprintf(w, "  %s: %s -> %s\n", r.Path, verdict(nowFails), verdict(wouldFail))
+			}
+		}
+		fmt.Fprintf(w, "  %d files would fail (currently %d)\n", would, current)
+	}
+	return nil
+}
+
+func verdict(failed bool) string {
+	if failed {
+		return "fail"
+	}
+	return "pass"
+}
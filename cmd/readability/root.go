@@ -0,0 +1,593 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/output"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/spell"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "readability [path]",
+	Short: "Analyze markdown documentation readability",
+	Long: `Analyze markdown files for readability and enforce the thresholds
+configured in .readability.yml.
+
+Given a directory, every .md file beneath it is analyzed. Given a file,
+only that file is. Given a .zip or .tar.gz doc bundle, its markdown
+entries are analyzed without extraction.
+
+Directories may hold .content-analyzerignore files: glob patterns one
+per line, # comments, and ! negation, matched relative to the file's
+directory with nearer files overriding broader ones — gitignore
+semantics, without touching the YAML config.
+
+Thresholds resolve in precedence order: built-in defaults, then the
+config file, then CONTENT_ANALYZER_* environment variables, then
+flags. Each variable maps to the threshold its suffix names in the
+config, lowercased: CONTENT_ANALYZER_MAX_GRADE sets max_grade,
+CONTENT_ANALYZER_MIN_EASE sets min_ease, and so on for every numeric
+threshold.
+
+Exit codes:
+  0 - All files within thresholds
+  1 - One or more files exceeded thresholds (with --check)
+  2 - Error occurred
+
+With --category-exit-codes, code 1 is replaced by a bitmask of the
+failing categories, so CI can branch per category:
+  4 - readability formulas (grade, ARI, fog, ease, sentence length)
+  8 - structure (lines, lists, headings, tables, code blocks)
+ 16 - style (spelling, whitespace, terminology)
+Combinations OR together: readability plus style failures exit 20.
+Code 2 still means an error occurred.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAnalyze,
+}
+
+var (
+	configPath string
+	formatName string
+	checkMode  bool
+	flagGrade  float64
+	spellMode  bool
+	outputPath string
+	styleMode  bool
+
+	maxColemanLiau float64
+	maxSMOG        float64
+
+	previewSpecs []string
+	relativeTo   string
+	verbosity    int
+	timingsTop   int
+	suggestMode  bool
+	strictMode   bool
+	tuiMode      bool
+	dumpProse    bool
+	structural   bool
+	noColor      bool
+
+	strictWarnings bool
+	explainMode    bool
+	language       string
+
+	countOnly      bool
+	findDuplicates bool
+	dupThreshold   float64
+	findOrphans    bool
+	maxFileSize    int64
+
+	sinceLastRun bool
+	stateFile    string
+	sqlitePath   string
+	recursive    bool
+	followLinks  bool
+	groupBy      string
+	smartQuotes  bool
+	categoryExit bool
+	printConfig  bool
+	topN         int
+	sortMetric   string
+	keepFM       bool
+	withMetadata bool
+	fixMode      bool
+
+	onlyChanged    bool
+	diffBase       string
+	histogram      bool
+	strictMarkdown bool
+	jsonCompact    bool
+	reportMargins  bool
+	templatePath   string
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", config.DefaultPath, "Path to configuration file")
+	rootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "Log analysis decisions to stderr (-v info, -vv debug)")
+	rootCmd.Flags().StringVar(&formatName, "format", "text", "Output format: text, json, ndjson, markdown, checklist, annotated, summary, badge")
+	rootCmd.Flags().BoolVar(&checkMode, "check", false, "Exit non-zero when thresholds are exceeded")
+	rootCmd.Flags().Float64Var(&flagGrade, "flag-grade", 0, "With --format annotated, mark sentences at or above this grade")
+	rootCmd.Flags().BoolVar(&spellMode, "spell", false, "Flag unknown words against the embedded dictionary plus "+spell.DictFile)
+	rootCmd.Flags().StringVar(&outputPath, "output", "", "Write formatted results to a file instead of stdout")
+	rootCmd.Flags().BoolVar(&styleMode, "whitespace", false, "Flag trailing whitespace, hard tabs in prose, and doubled blank lines")
+	rootCmd.Flags().Float64Var(&maxColemanLiau, "max-coleman-liau", 16, "Maximum Coleman-Liau index, overriding the config")
+	rootCmd.Flags().Float64Var(&maxSMOG, "max-smog", 18, "Maximum SMOG grade, overriding the config")
+	rootCmd.Flags().StringArrayVar(&previewSpecs, "preview-threshold", nil, "Preview pass/fail under a hypothetical threshold, e.g. max-grade=12 (repeatable)")
+	rootCmd.Flags().IntVar(&timingsTop, "timings", 0, "Report the N slowest files and total wall time")
+	rootCmd.Flags().BoolVar(&suggestMode, "suggest-thresholds", false, "Analyze the corpus and print recommended thresholds with a config snippet")
+	rootCmd.Flags().StringVar(&relativeTo, "relative-to", "", "Report file paths relative to this directory (default: the analyzed path)")
+	rootCmd.Flags().BoolVar(&strictMode, "strict", false, "Abort on the first unreadable or unparsable file instead of reporting it")
+	rootCmd.Flags().BoolVar(&tuiMode, "tui", false, "Browse results in an interactive terminal UI (falls back to the table when not a TTY)")
+	rootCmd.Flags().BoolVar(&dumpProse, "dump-prose", false, "Print the exact prose extracted from a single file and exit (debugging aid)")
+	rootCmd.Flags().BoolVar(&structural, "structural-only", false, "Skip the readability formulas and report structural metrics only; readability thresholds do not gate")
+	rootCmd.Flags().BoolVar(&noColor, "no-color", false, "Disable ANSI color in the table output")
+	rootCmd.Flags().BoolVar(&strictWarnings, "strict-warnings", false, "With --check, fail on warn-status files too")
+	rootCmd.Flags().BoolVar(&explainMode, "explain", false, "After the report, explain each failing metric in plain language with remediation tips")
+	rootCmd.Flags().StringVar(&language, "language", "", "Score with this language's readability formulas (es, de), overriding the config; frontmatter lang still wins per file")
+	rootCmd.Flags().BoolVar(&countOnly, "count-only", false, "Print file, word, and line totals with excludes applied, skipping readability and checks entirely")
+	rootCmd.Flags().BoolVar(&findDuplicates, "find-duplicates", false, "Report clusters of files with near-duplicate prose instead of analyzing")
+	rootCmd.Flags().Float64Var(&dupThreshold, "duplicate-threshold", 0.8, "Similarity (0-1) above which files count as duplicates, with --find-duplicates")
+	rootCmd.Flags().BoolVar(&findOrphans, "find-orphans", false, "Report pages with no inbound internal links instead of analyzing")
+	rootCmd.Flags().Int64Var(&maxFileSize, "max-file-size", 0, "Skip files larger than this many bytes without reading them (oversize_action picks skip or fail), overriding the config")
+	rootCmd.Flags().BoolVar(&sinceLastRun, "since-last-run", false, "Re-analyze only files changed since the last run, reporting the rest from the state file")
+	rootCmd.Flags().StringVar(&stateFile, "state-file", ".readability-state.json", "Where --since-last-run persists results between runs")
+	rootCmd.Flags().StringVar(&sqlitePath, "sqlite", "", "Append this run's per-file results to a SQLite database for trend tracking")
+	rootCmd.Flags().BoolVar(&recursive, "recursive", true, "Descend into subdirectories; --recursive=false checks only the directory's own files")
+	rootCmd.Flags().BoolVar(&followLinks, "follow-symlinks", false, "Descend into symlinked directories, erroring on symlink loops")
+	rootCmd.Flags().StringVar(&groupBy, "group-by", "", "Group the markdown or summary output into sections with subtotals: dir, status, or frontmatter:<field> (e.g. frontmatter:categories)")
+	rootCmd.Flags().BoolVar(&smartQuotes, "normalize-smart-quotes", true, "Fold smart quotes, dashes, and non-breaking spaces to ASCII before scoring")
+	rootCmd.Flags().BoolVar(&categoryExit, "category-exit-codes", false, "With --check, exit with a bitmask of failing categories instead of 1; see the command help")
+	rootCmd.Flags().BoolVar(&printConfig, "print-config", false, "Print the effective configuration after merging defaults, config file, and flags, then exit")
+	rootCmd.Flags().IntVar(&topN, "top", 0, "Report only the N worst files by the --sort metric, even when everything passes")
+	rootCmd.Flags().StringVar(&sortMetric, "sort", "grade", "Metric --top ranks by: grade, ari, fog, smog, coleman-liau, or ease")
+	rootCmd.Flags().BoolVar(&keepFM, "no-frontmatter-strip", false, "Count a leading frontmatter block as content instead of stripping it as metadata")
+	rootCmd.Flags().BoolVar(&withMetadata, "with-metadata", false, "With --format json, wrap the results in a header recording tool version, timestamp, path, and thresholds")
+	rootCmd.Flags().BoolVar(&fixMode, "fix", false, "Rewrite mechanical issues in place (whitespace, blank lines, smart typography, empty alt text) and report what changed")
+	rootCmd.Flags().BoolVar(&onlyChanged, "only-changed-lines", false, "Analyze only lines added since --diff-base (via git diff), scoring just the new prose per file")
+	rootCmd.Flags().StringVar(&diffBase, "diff-base", "HEAD", "Git ref --only-changed-lines diffs against, e.g. origin/main")
+	rootCmd.Flags().BoolVar(&histogram, "histogram", false, "After the report, render a bar histogram of the --sort metric across the corpus")
+	rootCmd.Flags().BoolVar(&strictMarkdown, "strict-markdown", false, "Flag malformed markdown: unclosed fences and code spans, unbalanced strong emphasis, undefined reference links")
+	rootCmd.Flags().BoolVar(&jsonCompact, "json-compact", false, "With --format json, emit a single line instead of pretty-printing")
+	rootCmd.Flags().BoolVar(&reportMargins, "report-threshold-margins", false, "Report each file's utilization of every numeric threshold, surfacing files close to failing")
+	rootCmd.Flags().StringVar(&templatePath, "template", "", "Render results through this Go text/template file instead of a built-in format; see pkg/output.Template for the data and helpers in scope")
+}
+
+func runAnalyze(cmd *cobra.Command, args []string) error {
+	path := "docs/"
+	if len(args) == 1 {
+		path = args[0]
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+	// Environment overrides sit between the config file and flags; see
+	// config.EnvPrefix for the mapping.
+	if err := cfg.ApplyEnv(); err != nil {
+		return err
+	}
+	if cmd.Flags().Changed("max-coleman-liau") {
+		cfg.Thresholds.MaxColemanLiau = &maxColemanLiau
+	}
+	if cmd.Flags().Changed("max-smog") {
+		cfg.Thresholds.MaxSMOG = &maxSMOG
+	}
+	if cmd.Flags().Changed("language") {
+		cfg.Analysis.Language = language
+	}
+	if cmd.Flags().Changed("max-file-size") {
+		cfg.MaxFileBytes = maxFileSize
+	}
+	if cmd.Flags().Changed("normalize-smart-quotes") {
+		cfg.Analysis.NormalizeSmartQuotes = &smartQuotes
+	}
+	// All flag overrides are applied by this point, so the dump shows
+	// exactly what the run would enforce.
+	if printConfig {
+		data, err := cfg.Dump()
+		if err != nil {
+			return err
+		}
+		_, err = cmd.OutOrStdout().Write(data)
+		return err
+	}
+	formatter, err := output.New(formatName)
+	if err != nil {
+		return err
+	}
+	if templatePath != "" {
+		if cmd.Flags().Changed("format") {
+			return fmt.Errorf("--template replaces --format; pass one or the other")
+		}
+		if formatter, err = output.NewTemplate(templatePath); err != nil {
+			return err
+		}
+	}
+	if jsonCompact && formatName != "json" {
+		return fmt.Errorf("--json-compact requires --format json, got %q", formatName)
+	}
+	if js, ok := formatter.(output.JSON); ok {
+		js.Compact = jsonCompact
+		formatter = js
+	}
+	if withMetadata {
+		if formatName != "json" {
+			return fmt.Errorf("--with-metadata requires --format json, got %q", formatName)
+		}
+		formatter = output.JSONEnvelope{Version: toolVersion(), Path: path, Thresholds: cfg.Thresholds, Compact: jsonCompact}
+	}
+
+	a := analyzer.New(cfg)
+	a.Log = newLogger(cmd.ErrOrStderr())
+	// Color only makes sense on a live terminal: files, pipes, and
+	// NO_COLOR environments all get the plain table.
+	if txt, ok := formatter.(output.Text); ok {
+		txt.Color = !noColor && os.Getenv("NO_COLOR") == "" && outputPath == "" &&
+			term.IsTerminal(int(os.Stdout.Fd()))
+		formatter = txt
+	}
+	if ann, ok := formatter.(output.Annotated); ok {
+		ann.FlagGrade = flagGrade
+		formatter = ann
+		a.IncludeSentences = true
+	}
+	if !output.ValidGroupBy(groupBy) {
+		return fmt.Errorf("unknown --group-by %q, want dir, status, or frontmatter:<field>", groupBy)
+	}
+	if field, ok := strings.CutPrefix(groupBy, output.GroupByFrontmatter); ok {
+		a.CategoryField = field
+	}
+	if md, ok := formatter.(output.Markdown); ok {
+		md.GroupBy = groupBy
+		formatter = md
+	}
+	if sm, ok := formatter.(output.Summary); ok {
+		sm.GroupBy = groupBy
+		formatter = sm
+	}
+	a.Whitespace = styleMode
+	a.StrictMarkdown = strictMarkdown
+	a.ReportMargins = reportMargins
+	if spellMode {
+		checker, err := spell.NewChecker(spell.DictFile)
+		if err != nil {
+			return err
+		}
+		a.Spell = checker
+	}
+
+	a.Timings = timingsTop > 0
+	a.Strict = strictMode
+	a.StructuralOnly = structural
+	a.NoRecurse = !recursive
+	a.FollowSymlinks = followLinks
+	a.KeepFrontmatter = keepFM
+	if err := loadWordLists(a, cfg); err != nil {
+		return err
+	}
+	// The fingerprint ties cached verdicts to the exact configuration,
+	// flag overrides included, so a threshold tweak re-analyzes
+	// everything instead of replaying stale results.
+	if sinceLastRun {
+		cache, err := analyzer.LoadCache(stateFile, analyzer.ConfigFingerprint(cfg))
+		if err != nil {
+			return err
+		}
+		a.Cache = cache
+	}
+
+	// Dumping prose answers "what did the formulas actually see" for
+	// one file; directories and archives have no single answer.
+	if dumpProse {
+		if info, err := os.Stat(path); err != nil {
+			return err
+		} else if info.IsDir() || analyzer.IsArchive(path) {
+			return fmt.Errorf("--dump-prose needs a single file, got %s", path)
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		prose, err := a.ExtractProse(path, src)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), prose)
+		return nil
+	}
+
+	// Fixing rewrites files rather than analyzing them; combining it
+	// with a gate would judge content the run itself just changed.
+	if fixMode {
+		if checkMode {
+			return fmt.Errorf("--fix cannot be combined with --check; fix first, then gate a clean run")
+		}
+		return runFix(cmd.OutOrStdout(), path)
+	}
+
+	// Count-only sizes the corpus: the same walk and excludes as a
+	// real run with none of the scoring, for scoping docs work and
+	// sanity-checking excludes before anything heavier.
+	if countOnly {
+		c, err := a.Count(path)
+		if err != nil {
+			return err
+		}
+		printCount(cmd.OutOrStdout(), c)
+		return nil
+	}
+
+	// Duplicate detection is a corpus-level scan, not per-file
+	// analysis; it reports its clusters and exits.
+	if findDuplicates {
+		clusters, err := a.FindDuplicates(path, dupThreshold)
+		if err != nil {
+			return err
+		}
+		printDuplicates(cmd.OutOrStdout(), clusters, path)
+		return nil
+	}
+
+	// Orphan detection is likewise corpus-level: it needs the whole
+	// link graph before any page can be called unreachable.
+	if findOrphans {
+		orphans, err := a.FindOrphans(path)
+		if err != nil {
+			return err
+		}
+		printOrphans(cmd.OutOrStdout(), orphans, path)
+		return nil
+	}
+
+	// The triage UI needs per-sentence scores for its detail view, and
+	// only makes sense on a real terminal; CI pipes get the table.
+	interactive := tuiMode && term.IsTerminal(int(os.Stdout.Fd()))
+	if interactive {
+		a.IncludeSentences = true
+	}
+
+	// ndjson streams each result as its file finishes, so huge runs
+	// need not buffer before downstream consumers see anything. Paths
+	// are normalized per result since the usual pass runs afterwards.
+	streaming := formatName == "ndjson" && outputPath == "" && !onlyChanged
+	if streaming {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		base := streamBase(path)
+		a.OnResult = func(r *analyzer.Result) {
+			if base != "" {
+				analyzer.RelativizePaths([]*analyzer.Result{r}, base)
+			}
+			enc.Encode(r)
+		}
+	}
+
+	// Summary and check only need totals. When nothing else in the run
+	// wants per-file results, fold each one into running counters as
+	// it is produced and let the analyzer drop it, so memory stays
+	// flat on very large corpora.
+	aggregatesOnly := (formatName == "summary" || streaming) && outputPath == "" &&
+		!interactive && !suggestMode && !explainMode && sqlitePath == "" &&
+		groupBy == "" && !categoryExit && topN == 0 && len(previewSpecs) == 0 &&
+		timingsTop == 0 && !onlyChanged && !histogram
+	var agg *output.Aggregate
+	if aggregatesOnly {
+		agg = &output.Aggregate{}
+		emit := a.OnResult
+		a.OnResult = func(r *analyzer.Result) {
+			if emit != nil {
+				emit(r)
+			}
+			agg.Add(r)
+		}
+		a.DiscardResults = true
+	}
+
+	start := time.Now()
+	var results []*analyzer.Result
+	if onlyChanged {
+		// PR mode: score only the text the author actually added, not
+		// the legacy files they touched.
+		results, err = analyzeChangedLines(a, path, diffBase)
+	} else {
+		results, err = a.AnalyzePath(path)
+	}
+	if err != nil {
+		return err
+	}
+	wall := time.Since(start)
+
+	// Persist before paths are relativized so the next run's lookups
+	// still key on the paths the walk produced.
+	if a.Cache != nil {
+		if err := a.Cache.Save(stateFile); err != nil {
+			return err
+		}
+	}
+
+	// Normalize result paths so reports are portable between machines.
+	// Relative to the analyzed directory by default; --relative-to
+	// rebases them, e.g. on the repository root in CI.
+	// Archive entries already carry clean in-archive paths, and
+	// streaming mode normalized per result on the way out.
+	if !analyzer.IsArchive(path) && !streaming {
+		base := relativeTo
+		if base == "" {
+			if info, err := os.Stat(path); err == nil && info.IsDir() {
+				base = path
+			} else {
+				base = filepath.Dir(path)
+			}
+		}
+		analyzer.RelativizePaths(results, base)
+	}
+
+	// The database append is best-effort on the SHA only: outside a git
+	// checkout the run still records, just without one.
+	if sqlitePath != "" {
+		sha := ""
+		if out, err := gitOutput(".", "rev-parse", "HEAD"); err == nil {
+			sha = strings.TrimSpace(out)
+		}
+		if err := output.ExportSQLite(sqlitePath, results, sha); err != nil {
+			return err
+		}
+	}
+
+	if suggestMode {
+		suggestThresholds(cmd.OutOrStdout(), results)
+		return nil
+	}
+	// --top narrows the report to the worst pages by one metric; the
+	// full run still happened, so --check below judges every file.
+	report := results
+	if topN > 0 {
+		report, err = worstN(results, sortMetric, topN)
+		if err != nil {
+			return err
+		}
+	}
+	if interactive {
+		if err := runTUI(results); err != nil {
+			return err
+		}
+	} else if aggregatesOnly {
+		if formatName == "summary" {
+			if err := agg.Write(cmd.OutOrStdout()); err != nil {
+				return err
+			}
+		}
+	} else if !streaming {
+		if err := writeResults(cmd, formatter, report); err != nil {
+			return err
+		}
+	}
+	if explainMode {
+		printExplanations(cmd.OutOrStdout(), results)
+	}
+	if histogram {
+		if err := printHistogram(cmd.OutOrStdout(), results, sortMetric); err != nil {
+			return err
+		}
+	}
+	if len(previewSpecs) > 0 {
+		if err := previewThresholds(cmd.OutOrStdout(), cfg, results, previewSpecs); err != nil {
+			return err
+		}
+	}
+	if timingsTop > 0 {
+		printTimings(cmd.OutOrStdout(), results, timingsTop, wall)
+	}
+
+	if checkMode {
+		if agg != nil {
+			if agg.Failed > 0 || agg.Errored > 0 || (strictWarnings && agg.Warned > 0) {
+				os.Exit(1)
+			}
+			return nil
+		}
+		if categoryExit {
+			for _, r := range results {
+				if r.Status == analyzer.StatusError {
+					os.Exit(2)
+				}
+			}
+			if code := categoryBits(results, strictWarnings); code != 0 {
+				os.Exit(code)
+			}
+			return nil
+		}
+		for _, r := range results {
+			if r.Status == analyzer.StatusFail || r.Status == analyzer.StatusError {
+				os.Exit(1)
+			}
+			if strictWarnings && r.Status == analyzer.StatusWarn {
+				os.Exit(1)
+			}
+		}
+	}
+	return nil
+}
+
+// loadWordLists applies configured abbreviation and stopword files to
+// the analyzer, merging with or replacing the built-in defaults per
+// analysis.word_list_mode.
+func loadWordLists(a *analyzer.Analyzer, cfg *config.Config) error {
+	replace := cfg.Analysis.WordListMode == config.WordListReplace
+	if f := cfg.Analysis.AbbreviationsFile; f != "" {
+		words, err := analyzer.LoadWordList(f)
+		if err != nil {
+			return err
+		}
+		a.Abbreviations = analyzer.AbbreviationSet(words, replace)
+	}
+	if f := cfg.Analysis.StopwordsFile; f != "" {
+		words, err := analyzer.LoadWordList(f)
+		if err != nil {
+			return err
+		}
+		a.Stopwords = analyzer.StopwordSet(words, replace)
+	}
+	return nil
+}
+
+// streamBase resolves the directory result paths are relativized
+// against in streaming mode; empty for archives.
+func streamBase(path string) string {
+	if analyzer.IsArchive(path) {
+		return ""
+	}
+	if relativeTo != "" {
+		return relativeTo
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return path
+	}
+	return filepath.Dir(path)
+}
+
+// writeResults renders results to stdout, or to --output with status
+// reporting kept on stderr so reports stay clean for artifact upload.
+func writeResults(cmd *cobra.Command, formatter output.Formatter, results []*analyzer.Result) error {
+	if outputPath == "" {
+		return formatter.Format(cmd.OutOrStdout(), results)
+	}
+
+	if dir := filepath.Dir(outputPath); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+	}
+	f, err := os.Create(outputPath)
+	if err != nil {
+		return err
+	}
+	if err := formatter.Format(f, results); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		if r.Status == analyzer.StatusFail {
+			failed++
+		}
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "%d files analyzed, %d failed; results written to %s\n",
+		len(results), failed, outputPath)
+	return nil
+}
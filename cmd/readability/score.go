@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+var scoreText string
+
+var scoreCmd = &cobra.Command{
+	Use:   "score [text]",
+	Short: "Score a pasted snippet without a file",
+	Long: `Score a quoted snippet straight from the command line and print its
+readability metrics. For writers drafting a paragraph who want a quick
+grade without saving a file; thresholds still evaluate, but the
+min_words skip floor is lifted so short snippets score.`,
+	Args: cobra.ArbitraryArgs,
+	RunE: runScore,
+}
+
+func init() {
+	scoreCmd.Flags().StringVar(&scoreText, "text", "", "The text to score, as an alternative to a positional argument")
+	rootCmd.AddCommand(scoreCmd)
+}
+
+func runScore(cmd *cobra.Command, args []string) error {
+	text := scoreText
+	if text == "" {
+		text = strings.Join(args, " ")
+	}
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("nothing to score: pass text as an argument or via --text")
+	}
+
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+	// A pasted paragraph is almost always under the corpus skip floor;
+	// scoring it is the whole point.
+	cfg.Thresholds.MinWords = nil
+
+	a := analyzer.New(cfg)
+	res, err := a.Analyze("snippet.md", []byte(text))
+	if err != nil {
+		return err
+	}
+
+	m := res.Metrics
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "grade\t%.1f\t%s\n", m.FleschKincaid, m.GradeLabel)
+	fmt.Fprintf(tw, "ari\t%.1f\n", m.ARI)
+	fmt.Fprintf(tw, "fog\t%.1f\n", m.GunningFog)
+	fmt.Fprintf(tw, "ease\t%.1f\n", m.FleschEase)
+	fmt.Fprintf(tw, "coleman-liau\t%.1f\n", m.ColemanLiau)
+	fmt.Fprintf(tw, "smog\t%.1f\n", m.SMOG)
+	fmt.Fprintf(tw, "words\t%d\n", m.Words)
+	fmt.Fprintf(tw, "sentences\t%d\n", m.Sentences)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	for _, f := range res.Failures {
+		fmt.Fprintf(cmd.OutOrStdout(), "%s: %s\n", f.Severity, f.Message)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [path]",
+	Short: "Print corpus-wide aggregates",
+	Long: `Walk the tree and print aggregates about the whole corpus: median
+and p90 grade, total words, average file length, heading-level and
+code-ratio distributions.
+
+This is for understanding a docs tree and choosing realistic
+thresholds; nothing here gates. Use the root command with --check for
+enforcement.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runStats,
+}
+
+var (
+	statsJSON       bool
+	statsDistMetric string
+)
+
+func init() {
+	statsCmd.Flags().BoolVar(&statsJSON, "json", false, "Output aggregates as JSON")
+	statsCmd.Flags().StringVar(&statsDistMetric, "distribution-metric", "grade",
+		"Metric the readability distribution buckets by: grade (matches the gate) or ease")
+	rootCmd.AddCommand(statsCmd)
+}
+
+func runStats(cmd *cobra.Command, args []string) error {
+	path := "docs/"
+	if len(args) == 1 {
+		path = args[0]
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+
+	a := analyzer.New(cfg)
+	a.Log = newLogger(cmd.ErrOrStderr())
+	results, err := a.AnalyzePath(path)
+	if err != nil {
+		return err
+	}
+	stats := analyzer.ComputeStats(results)
+	stats.Distribution, err = analyzer.ComputeDistribution(results, statsDistMetric)
+	if err != nil {
+		return err
+	}
+	stats.DistributionMetric = statsDistMetric
+
+	if statsJSON {
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(stats)
+	}
+
+	w := cmd.OutOrStdout()
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "files\t%d\n", stats.Files)
+	fmt.Fprintf(tw, "total words\t%d\n", stats.TotalWords)
+	fmt.Fprintf(tw, "avg lines per file\t%.1f\n", stats.AvgLines)
+	fmt.Fprintf(tw, "grade median / p90\t%.1f / %.1f\n", stats.MedianGrade, stats.P90Grade)
+	fmt.Fprintf(tw, "code ratio median / p90\t%.0f%% / %.0f%%\n", stats.MedianCodeRatio*100, stats.P90CodeRatio*100)
+	for i, n := range stats.HeadingsByLevel {
+		fmt.Fprintf(tw, "h%d headings\t%d\n", i+1, n)
+	}
+	for _, b := range stats.Distribution {
+		fmt.Fprintf(tw, "%s (%s)\t%d\n", b.Label, statsDistMetric, b.Count)
+	}
+	return tw.Flush()
+}
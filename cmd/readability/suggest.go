@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// suggestThresholds prints data-driven threshold recommendations from a
+// corpus: the 90th percentile of each gating metric, rounded outward so
+// most existing content passes and only outliers fail. The output ends
+// with a config snippet ready to paste into .readability.yml.
+func suggestThresholds(w io.Writer, results []*analyzer.Result) {
+	var grades, aris, fogs, eases, cls, smogs []float64
+	files := 0
+	for _, r := range results {
+		if r.Status == analyzer.StatusSkipped || r.Metrics.Words == 0 {
+			continue
+		}
+		files++
+		grades = append(grades, r.Metrics.FleschKincaid)
+		aris = append(aris, r.Metrics.ARI)
+		fogs = append(fogs, r.Metrics.GunningFog)
+		eases = append(eases, r.Metrics.FleschEase)
+		cls = append(cls, r.Metrics.ColemanLiau)
+		smogs = append(smogs, r.Metrics.SMOG)
+	}
+	if files == 0 {
+		fmt.Fprintln(w, "no scorable files; nothing to suggest")
+		return
+	}
+
+	maxGrade := math.Ceil(analyzer.Percentile(grades, 90))
+	maxARI := math.Ceil(analyzer.Percentile(aris, 90))
+	maxFog := math.Ceil(analyzer.Percentile(fogs, 90))
+	minEase := math.Floor(analyzer.Percentile(eases, 10))
+	maxCL := math.Ceil(analyzer.Percentile(cls, 90))
+	maxSMOG := math.Ceil(analyzer.Percentile(smogs, 90))
+
+	fmt.Fprintf(w, "Suggested thresholds from %d scorable files.\n", files)
+	fmt.Fprintf(w, "Each limit sits at the 90th percentile (10th for ease) of the\n")
+	fmt.Fprintf(w, "current corpus, rounded outward: roughly 90%% of existing pages\n")
+	fmt.Fprintf(w, "pass immediately and only outliers need editing. Tighten the\n")
+	fmt.Fprintf(w, "numbers over time as the outliers improve.\n\n")
+
+	fmt.Fprintln(w, "thresholds:")
+	fmt.Fprintf(w, "  max_grade: %.0f\n", maxGrade)
+	fmt.Fprintf(w, "  max_ari: %.0f\n", maxARI)
+	fmt.Fprintf(w, "  max_fog: %.0f\n", maxFog)
+	fmt.Fprintf(w, "  min_ease: %.0f\n", minEase)
+	fmt.Fprintf(w, "  max_coleman_liau: %.0f\n", maxCL)
+	fmt.Fprintf(w, "  max_smog: %.0f\n", maxSMOG)
+}
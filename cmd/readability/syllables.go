@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"unicode"
+
+	"github.com/spf13/cobra"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+var syllableFloor int
+
+var syllablesCmd = &cobra.Command{
+	Use:   "syllables [path]",
+	Short: "List high-syllable words to seed syllable_overrides",
+	Long: `Scan the corpus for words the syllable estimator counts at or above a
+floor and print them with their estimate and frequency. Words the
+estimator gets wrong belong in analysis.syllable_overrides, since FK
+and SMOG inherit the estimator's error on technical terms.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSyllables,
+}
+
+func init() {
+	syllablesCmd.Flags().IntVar(&syllableFloor, "min-syllables", 4, "Only list words estimated at or above this many syllables")
+	rootCmd.AddCommand(syllablesCmd)
+}
+
+func runSyllables(cmd *cobra.Command, args []string) error {
+	path := "docs/"
+	if len(args) == 1 {
+		path = args[0]
+	}
+	cfg, err := config.Load(configPath)
+	if err != nil {
+		return err
+	}
+	a := analyzer.New(cfg)
+
+	type entry struct {
+		syllables int
+		count     int
+	}
+	seen := map[string]*entry{}
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		if !strings.HasSuffix(p, ".md") && !strings.HasSuffix(p, ".rst") {
+			return nil
+		}
+		src, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		prose, err := a.Prose(p, src)
+		if err != nil {
+			return nil // unparsable files are the analyze command's problem
+		}
+		for _, w := range strings.Fields(prose) {
+			word := strings.ToLower(strings.TrimFunc(w, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+			}))
+			if word == "" {
+				continue
+			}
+			syl := analyzer.Syllables(word)
+			if syl < syllableFloor {
+				continue
+			}
+			if e, ok := seen[word]; ok {
+				e.count++
+			} else {
+				seen[word] = &entry{syllables: syl, count: 1}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	words := make([]string, 0, len(seen))
+	for w := range seen {
+		words = append(words, w)
+	}
+	sort.Slice(words, func(i, j int) bool {
+		if seen[words[i]].count != seen[words[j]].count {
+			return seen[words[i]].count > seen[words[j]].count
+		}
+		return words[i] < words[j]
+	})
+
+	tw := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "word\testimate\toccurrences")
+	for _, w := range words {
+		fmt.Fprintf(tw, "%s\t%d\t%d\n", w, seen[w].syllables, seen[w].count)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// printTimings reports the slowest n files and the total wall time of
+// the run. Slow files are almost always pathologically large ones worth
+// splitting.
+func printTimings(w io.Writer, results []*analyzer.Result, n int, total time.Duration) {
+	sorted := make([]*analyzer.Result, len(results))
+	copy(sorted, results)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].DurationMS > sorted[j].DurationMS
+	})
+	if n > len(sorted) {
+		n = len(sorted)
+	}
+
+	fmt.Fprintf(w, "\nslowest %d files:\n", n)
+	for _, r := range sorted[:n] {
+		fmt.Fprintf(w, "  %8.2fms  %s\n", r.DurationMS, r.Path)
+	}
+	fmt.Fprintf(w, "total wall time: %s\n", total.Round(time.Millisecond))
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+var tocCmd = &cobra.Command{
+	Use:   "toc <file>",
+	Short: "Generate a table of contents for a markdown file",
+	Long: `Generate a markdown table of contents from a file's headings: a
+nested bullet list of anchor links matching the anchors the site
+generator produces.
+
+By default the TOC prints to stdout. With --in-place the file is
+rewritten with the TOC inserted between ` + "`" + markdown.TOCOpen + "`" + ` and
+` + "`" + markdown.TOCClose + "`" + ` markers, which must already be present.`,
+	Example: `  # Print a TOC for a page
+  readability toc docs/build/index.md
+
+  # Include only H2 headings
+  readability toc --min-depth 2 --max-depth 2 docs/build/index.md
+
+  # Update the TOC between <!-- toc --> markers
+  readability toc --in-place docs/build/index.md`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTOC,
+}
+
+var (
+	tocMinDepth int
+	tocMaxDepth int
+	tocInPlace  bool
+)
+
+func init() {
+	tocCmd.Flags().IntVar(&tocMinDepth, "min-depth", 2, "Minimum heading level to include")
+	tocCmd.Flags().IntVar(&tocMaxDepth, "max-depth", 3, "Maximum heading level to include")
+	tocCmd.Flags().BoolVar(&tocInPlace, "in-place", false, "Insert or update the TOC between markers in the file")
+	rootCmd.AddCommand(tocCmd)
+}
+
+func runTOC(cmd *cobra.Command, args []string) error {
+	path := args[0]
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	doc, err := markdown.Parse(path, src)
+	if err != nil {
+		return err
+	}
+	toc := doc.TOC(tocMinDepth, tocMaxDepth)
+
+	if !tocInPlace {
+		fmt.Fprint(cmd.OutOrStdout(), toc)
+		return nil
+	}
+
+	updated, ok := markdown.InsertTOC(src, toc)
+	if !ok {
+		return fmt.Errorf("%s: no %s markers found", path, markdown.TOCOpen)
+	}
+	return os.WriteFile(path, updated, 0o644)
+}
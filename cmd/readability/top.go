@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// worstN sorts scored results worst-first by a metric and keeps the
+// first n, for --top cleanup runs. Skipped and errored entries drop;
+// they have no score to rank. Higher is worse for every metric except
+// ease, where low scores mark the hard pages.
+func worstN(results []*analyzer.Result, metric string, n int) ([]*analyzer.Result, error) {
+	key, err := metricKey(metric)
+	if err != nil {
+		return nil, err
+	}
+	scored := make([]*analyzer.Result, 0, len(results))
+	for _, r := range results {
+		if r.Status == analyzer.StatusSkipped || r.Status == analyzer.StatusError {
+			continue
+		}
+		scored = append(scored, r)
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return key(scored[i]) > key(scored[j])
+	})
+	if n < len(scored) {
+		scored = scored[:n]
+	}
+	return scored, nil
+}
+
+// metricKey maps a --sort name to a worst-is-highest score accessor.
+func metricKey(metric string) (func(*analyzer.Result) float64, error) {
+	switch metric {
+	case "grade":
+		return func(r *analyzer.Result) float64 { return r.Metrics.FleschKincaid }, nil
+	case "ari":
+		return func(r *analyzer.Result) float64 { return r.Metrics.ARI }, nil
+	case "fog":
+		return func(r *analyzer.Result) float64 { return r.Metrics.GunningFog }, nil
+	case "smog":
+		return func(r *analyzer.Result) float64 { return r.Metrics.SMOG }, nil
+	case "coleman-liau":
+		return func(r *analyzer.Result) float64 { return r.Metrics.ColemanLiau }, nil
+	case "ease":
+		// Ease runs the other way: 30 reads harder than 70.
+		return func(r *analyzer.Result) float64 { return -r.Metrics.FleschEase }, nil
+	}
+	return nil, fmt.Errorf("unknown --sort metric %q, want grade, ari, fog, smog, coleman-liau, or ease", metric)
+}
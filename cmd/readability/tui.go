@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// runTUI opens the interactive triage view over a finished result set.
+// It is a navigation layer only: analysis has already happened, so
+// quitting and re-running with different flags stays cheap.
+func runTUI(results []*analyzer.Result) error {
+	m := tuiModel{results: results}
+	m.sortResults()
+	_, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	return err
+}
+
+// tuiSortModes orders the sort key cycle bound to "s".
+var tuiSortModes = []string{"path", "grade", "words", "failures"}
+
+// tuiModel is the bubbletea model for triage mode: a sortable file
+// list, with a per-file detail view showing failures and the longest
+// sentences so a writer knows where to start editing.
+type tuiModel struct {
+	results []*analyzer.Result
+
+	cursor   int
+	offset   int
+	sortMode int
+	height   int
+
+	// selected is the file open in the detail view, nil for the list.
+	selected *analyzer.Result
+}
+
+// Init implements tea.Model.
+func (m tuiModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update implements tea.Model.
+func (m tuiModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.height = msg.Height
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c":
+			return m, tea.Quit
+		case "esc":
+			if m.selected != nil {
+				m.selected = nil
+				return m, nil
+			}
+			return m, tea.Quit
+		case "up", "k":
+			if m.selected == nil && m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.selected == nil && m.cursor < len(m.results)-1 {
+				m.cursor++
+			}
+		case "s":
+			if m.selected == nil {
+				m.sortMode = (m.sortMode + 1) % len(tuiSortModes)
+				m.sortResults()
+				m.cursor = 0
+			}
+		case "enter":
+			if m.selected == nil && len(m.results) > 0 {
+				m.selected = m.results[m.cursor]
+			}
+		}
+	}
+	m.clampScroll()
+	return m, nil
+}
+
+// View implements tea.Model.
+func (m tuiModel) View() string {
+	if m.selected != nil {
+		return m.detailView()
+	}
+	return m.listView()
+}
+
+func (m *tuiModel) sortResults() {
+	rs := m.results
+	switch tuiSortModes[m.sortMode] {
+	case "grade":
+		sort.SliceStable(rs, func(i, j int) bool {
+			return rs[i].Metrics.FleschKincaid > rs[j].Metrics.FleschKincaid
+		})
+	case "words":
+		sort.SliceStable(rs, func(i, j int) bool {
+			return rs[i].Metrics.Words > rs[j].Metrics.Words
+		})
+	case "failures":
+		sort.SliceStable(rs, func(i, j int) bool {
+			return len(rs[i].Failures) > len(rs[j].Failures)
+		})
+	default:
+		sort.SliceStable(rs, func(i, j int) bool {
+			return rs[i].Path < rs[j].Path
+		})
+	}
+}
+
+// clampScroll keeps the cursor inside the visible window.
+func (m *tuiModel) clampScroll() {
+	visible := m.height - 3 // header, footer, sort line
+	if visible < 1 {
+		visible = 1
+	}
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+visible {
+		m.offset = m.cursor - visible + 1
+	}
+}
+
+func (m tuiModel) listView() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "readability triage — %d files — sort: %s\n",
+		len(m.results), tuiSortModes[m.sortMode])
+
+	visible := m.height - 3
+	if visible < 1 {
+		visible = len(m.results)
+	}
+	end := m.offset + visible
+	if end > len(m.results) {
+		end = len(m.results)
+	}
+	for i := m.offset; i < end; i++ {
+		r := m.results[i]
+		marker := "  "
+		if i == m.cursor {
+			marker = "> "
+		}
+		if r.Status == analyzer.StatusSkipped || r.Status == analyzer.StatusError {
+			fmt.Fprintf(&b, "%s%-7s %s\n", marker, r.Status, r.Path)
+			continue
+		}
+		fmt.Fprintf(&b, "%s%-7s grade %5.1f  words %5d  fails %d  %s\n",
+			marker, r.Status, r.Metrics.FleschKincaid, r.Metrics.Words, len(r.Failures), r.Path)
+	}
+	b.WriteString("\n↑/↓ move · enter open · s sort · q quit")
+	return b.String()
+}
+
+func (m tuiModel) detailView() string {
+	r := m.selected
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s — %s\n\n", r.Path, r.Status)
+	if r.Error != "" {
+		fmt.Fprintf(&b, "error: %s\n", r.Error)
+	}
+	mt := r.Metrics
+	fmt.Fprintf(&b, "grade %.1f %s · ari %.1f · fog %.1f · ease %.1f · words %d · lines %d\n\n",
+		mt.FleschKincaid, mt.GradeLabel, mt.ARI, mt.GunningFog, mt.FleschEase, mt.Words, mt.Lines)
+
+	if len(r.Failures) > 0 {
+		b.WriteString("failures:\n")
+		for _, f := range r.Failures {
+			fmt.Fprintf(&b, "  %s: %s\n", f.Severity, f.Message)
+		}
+		b.WriteString("\n")
+	}
+
+	if longest := longestSentences(r.Sentences, 5); len(longest) > 0 {
+		b.WriteString("longest sentences:\n")
+		for _, s := range longest {
+			fmt.Fprintf(&b, "  [%5.1f] %s\n", s.Grade, s.Text)
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString("esc back · q quit")
+	return b.String()
+}
+
+// longestSentences returns the n longest sentences by word count,
+// longest first, without reordering the result's own slice.
+func longestSentences(sentences []analyzer.Sentence, n int) []analyzer.Sentence {
+	out := make([]analyzer.Sentence, len(sentences))
+	copy(out, sentences)
+	sort.SliceStable(out, func(i, j int) bool {
+		return len(strings.Fields(out[i].Text)) > len(strings.Fields(out[j].Text))
+	})
+	if len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
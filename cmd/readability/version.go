@@ -0,0 +1,13 @@
+package main
+
+import "runtime/debug"
+
+// toolVersion reports the version baked into the binary by the Go
+// module system: the release tag for tagged builds, a pseudo-version
+// for go install @main, and "dev" for plain source builds.
+func toolVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return "dev"
+}
@@ -0,0 +1,165 @@
+// Package adoc extracts prose and headings from AsciiDoc files so the
+// readability pipeline can treat them like markdown. It is a pragmatic
+// line-based extractor, not an Asciidoctor implementation: attribute
+// entries, comments, and block macros are stripped, delimited source
+// and literal blocks count as code, inline markup reduces to its text,
+// and =-style headings are recognized.
+package adoc
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+// Document is the parsed representation of an AsciiDoc file, shaped
+// like markdown.Document where the analyzer needs it.
+type Document struct {
+	Path   string
+	Source []byte
+
+	blocks    []markdown.Block
+	headings  []markdown.Heading
+	codeLines int
+}
+
+var (
+	attributeEntry = regexp.MustCompile(`^:[\w!-]+:`)
+	blockMacro     = regexp.MustCompile(`^[\w-]+::[^\[]*\[[^\]]*\]\s*$`)
+	urlMacro       = regexp.MustCompile(`(?:https?://[^\s\[]+|(?:link|xref):[^\[\s]+)\[([^\]]*)\]`)
+	inlineCode     = regexp.MustCompile("`([^`]*)`")
+	emphSpan       = regexp.MustCompile(`(^|[\s(])[*_]([^*_]+)[*_]($|[\s).,;:!?])`)
+	bulletLine     = regexp.MustCompile(`^\s*(?:[-*]+|\.+)\s+`)
+)
+
+// isDelimiter reports whether a line opens or closes a delimited block:
+// four or more repeats of a single block punctuation character.
+func isDelimiter(s string) bool {
+	if len(s) < 4 || !strings.ContainsRune(`-.=*_/+`, rune(s[0])) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse extracts blocks and headings from src.
+func Parse(path string, src []byte) (*Document, error) {
+	d := &Document{Path: path, Source: src}
+
+	lines := strings.Split(string(src), "\n")
+	slugger := markdown.NewSlugger()
+	var para []string
+
+	flush := func(kind markdown.BlockKind) {
+		if len(para) == 0 {
+			return
+		}
+		text := inlineText(strings.Join(para, " "))
+		if text != "" {
+			d.blocks = append(d.blocks, markdown.Block{Kind: kind, Text: text})
+		}
+		para = nil
+	}
+
+	delimiter := "" // inside a delimited block when non-empty
+	code := false
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+
+		if delimiter != "" {
+			if trimmed == delimiter {
+				delimiter = ""
+				code = false
+				continue
+			}
+			if code {
+				d.codeLines++
+			}
+			continue
+		}
+
+		if trimmed == "" {
+			flush(markdown.BlockParagraph)
+			continue
+		}
+
+		// Attribute entries, comments, and block macros carry no prose.
+		if attributeEntry.MatchString(trimmed) || strings.HasPrefix(trimmed, "//") ||
+			blockMacro.MatchString(trimmed) {
+			flush(markdown.BlockParagraph)
+			continue
+		}
+
+		// Block attribute lines like [source,go] or [NOTE] mark the
+		// following delimited block; source and literal ones are code.
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+			flush(markdown.BlockParagraph)
+			code = strings.HasPrefix(trimmed, "[source") || strings.HasPrefix(trimmed, "[listing") ||
+				strings.HasPrefix(trimmed, "[literal")
+			continue
+		}
+
+		if isDelimiter(trimmed) {
+			flush(markdown.BlockParagraph)
+			delimiter = trimmed
+			// ---- and .... delimit listing and literal blocks, which
+			// are code even without a [source] attribute line.
+			if trimmed[0] == '-' || trimmed[0] == '.' {
+				code = true
+			}
+			continue
+		}
+
+		// =-style headings: "= Title", "== Section", and so on.
+		if marker := strings.TrimRight(strings.SplitN(trimmed, " ", 2)[0], "="); marker == "" && strings.Contains(trimmed, " ") {
+			flush(markdown.BlockParagraph)
+			level := strings.IndexByte(trimmed+" ", ' ')
+			text := inlineText(strings.TrimSpace(trimmed[level:]))
+			d.headings = append(d.headings, markdown.Heading{
+				Level: level,
+				Text:  text,
+				Slug:  slugger.Slug(text),
+			})
+			d.blocks = append(d.blocks, markdown.Block{Kind: markdown.BlockHeading, Text: text})
+			continue
+		}
+
+		if bulletLine.MatchString(lines[i]) {
+			flush(markdown.BlockParagraph)
+			para = append(para, bulletLine.ReplaceAllString(lines[i], ""))
+			flush(markdown.BlockListItem)
+			continue
+		}
+
+		para = append(para, trimmed)
+	}
+	flush(markdown.BlockParagraph)
+	return d, nil
+}
+
+// inlineText reduces inline markup to its text: link and xref macros
+// keep their label, inline code keeps its content, and bold and italic
+// markers drop.
+func inlineText(s string) string {
+	s = urlMacro.ReplaceAllString(s, "$1")
+	s = inlineCode.ReplaceAllString(s, "$1")
+	s = emphSpan.ReplaceAllString(s, "$1$2$3")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Bytes returns the raw file content.
+func (d *Document) Bytes() []byte { return d.Source }
+
+// Blocks returns the document's prose blocks in order.
+func (d *Document) Blocks() []markdown.Block { return d.blocks }
+
+// Headings returns the document's headings in order.
+func (d *Document) Headings() []markdown.Heading { return d.headings }
+
+// CodeLines counts the lines inside source and literal blocks.
+func (d *Document) CodeLines() int { return d.codeLines }
@@ -0,0 +1,71 @@
+package adoc
+
+import (
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+const sample = `= Page Title
+:toc: left
+
+// a comment line
+
+Intro paragraph with a https://example.com[link label] and ` + "`inline code`" + `.
+
+== Section One
+
+image::diagram.png[Architecture]
+
+[source,go]
+----
+func main() {}
+fmt.Println("hi")
+----
+
+* first item
+* second item
+`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse("sample.adoc", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs := doc.Headings()
+	if len(hs) != 2 {
+		t.Fatalf("got %d headings, want 2: %+v", len(hs), hs)
+	}
+	if hs[0].Level != 1 || hs[0].Text != "Page Title" || hs[0].Slug != "page-title" {
+		t.Errorf("heading 0 = %+v", hs[0])
+	}
+	if hs[1].Level != 2 || hs[1].Text != "Section One" {
+		t.Errorf("heading 1 = %+v", hs[1])
+	}
+
+	if doc.CodeLines() != 2 {
+		t.Errorf("CodeLines = %d, want 2", doc.CodeLines())
+	}
+
+	var items int
+	for _, b := range doc.Blocks() {
+		switch b.Kind {
+		case markdown.BlockParagraph:
+			if b.Text == "func main() {}" {
+				t.Error("source block leaked into prose")
+			}
+		case markdown.BlockListItem:
+			items++
+		}
+	}
+	if items != 2 {
+		t.Errorf("got %d list items, want 2", items)
+	}
+	for _, b := range doc.Blocks() {
+		if b.Text == "Intro paragraph with a link label and inline code." {
+			return
+		}
+	}
+	t.Errorf("intro paragraph not extracted cleanly: %+v", doc.Blocks())
+}
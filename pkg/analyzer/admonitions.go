@@ -0,0 +1,104 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// admonitionMarker matches a Material for MkDocs admonition opener:
+// !!! for static blocks, ??? or ???+ for collapsible ones, capturing
+// the indentation and whatever follows the marker.
+var admonitionMarker = regexp.MustCompile(`^(\s*)(!!!|\?\?\?\+?)\s*(.*)$`)
+
+// definitionLine matches a definition-list definition: a colon at the
+// start of the line followed by whitespace or nothing.
+var definitionLine = regexp.MustCompile(`^:(\s|$)`)
+
+// CheckAdmonitions validates the Material-specific constructs whose
+// syntax breaks silently: admonition blocks and definition lists. An
+// admonition body indented fewer than four spaces renders as a plain
+// paragraph outside the box; a definition with no term above it renders
+// as a literal colon. Both look fine in the source and wrong on the
+// published page, so only unambiguous breakage is flagged.
+func CheckAdmonitions(src []byte) []string {
+	var out []string
+	lines := strings.Split(string(src), "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		if m := admonitionMarker.FindStringSubmatch(line); m != nil {
+			indent := len(m[1])
+			rest := strings.TrimSpace(m[3])
+			if rest == "" {
+				out = append(out, fmt.Sprintf("admonition at line %d declares no type", i+1))
+			}
+			// The body is the next non-blank line; it must sit four
+			// spaces deeper than the marker to land inside the box.
+			j := i + 1
+			for j < len(lines) && strings.TrimSpace(lines[j]) == "" {
+				j++
+			}
+			if j >= len(lines) || lineIndent(lines[j]) <= indent {
+				out = append(out, fmt.Sprintf("admonition at line %d has no indented body", i+1))
+			} else if got := lineIndent(lines[j]); got < indent+4 {
+				out = append(out, fmt.Sprintf("admonition body at line %d is indented %d spaces; needs %d to render inside the block",
+					j+1, got, indent+4))
+			}
+			continue
+		}
+
+		if definitionLine.MatchString(line) {
+			if strings.TrimSpace(line[1:]) == "" {
+				out = append(out, fmt.Sprintf("definition at line %d is empty", i+1))
+				continue
+			}
+			// A definition may sit directly under its term or one blank
+			// line below it (loose-list spacing); two or more blank
+			// lines detach it and it renders as a literal colon.
+			blankAbove := i > 0 && strings.TrimSpace(lines[i-1]) == ""
+			detached := blankAbove && (i == 1 || strings.TrimSpace(lines[i-2]) == "")
+			if i == 0 || detached && !prevIsDefinitionList(lines, i) {
+				out = append(out, fmt.Sprintf("definition at line %d has no term above it", i+1))
+			}
+		}
+	}
+	return out
+}
+
+// lineIndent counts a line's leading spaces, with tabs counted as four.
+func lineIndent(line string) int {
+	n := 0
+	for _, r := range line {
+		switch r {
+		case ' ':
+			n++
+		case '\t':
+			n += 4
+		default:
+			return n
+		}
+	}
+	return n
+}
+
+// prevIsDefinitionList reports whether the blank line above lines[i]
+// separates it from an earlier definition in the same list, which is
+// legal loose-list spacing rather than an orphaned definition.
+func prevIsDefinitionList(lines []string, i int) bool {
+	for j := i - 1; j >= 0; j-- {
+		if strings.TrimSpace(lines[j]) == "" {
+			continue
+		}
+		return definitionLine.MatchString(lines[j]) || lineIndent(lines[j]) >= 4
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckAdmonitionsClean(t *testing.T) {
+	src := []byte("# T\n\n!!! note \"Heads up\"\n\n    Indented body inside the box.\n\nTerm\n:   Its definition.\n:   A second definition.\n\n```text\n!!! not an admonition, inside a fence\n```\n")
+	if got := CheckAdmonitions(src); len(got) != 0 {
+		t.Fatalf("CheckAdmonitions on clean file = %v, want none", got)
+	}
+}
+
+func TestCheckAdmonitionsIssues(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{"no type", "!!!\n\n    Body.\n", "declares no type"},
+		{"no body", "!!! note\n\nPlain paragraph at the margin.\n", "no indented body"},
+		{"shallow body", "!!! note\n\n  Two-space body.\n", "indented 2 spaces; needs 4"},
+		{"collapsible shallow", "??? tip\n   Three-space body.\n", "indented 3 spaces; needs 4"},
+		{"orphan definition", "Paragraph.\n\n\n:   Definition with no term.\n", "no term above it"},
+		{"empty definition", "Term\n:\n", "definition at line 2 is empty"},
+	}
+	for _, tc := range cases {
+		got := CheckAdmonitions([]byte(tc.src))
+		if len(got) != 1 {
+			t.Errorf("%s: CheckAdmonitions = %v, want 1 issue", tc.name, got)
+			continue
+		}
+		if !strings.Contains(got[0], tc.want) {
+			t.Errorf("%s: issue %q, want substring %q", tc.name, got[0], tc.want)
+		}
+	}
+}
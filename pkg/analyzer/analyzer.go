@@ -0,0 +1,1270 @@
+// Package analyzer scores markdown documents for readability and checks
+// them against configured thresholds.
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/adoc"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/htmldoc"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/notebook"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/rst"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/spell"
+)
+
+// Document is the parser-independent view of a file the analyzer and
+// custom checks work with. The markdown, reStructuredText, AsciiDoc,
+// and Jupyter notebook parsers all satisfy it.
+type Document interface {
+	// Blocks returns the prose blocks in order.
+	Blocks() []markdown.Block
+
+	// Headings returns the headings in order.
+	Headings() []markdown.Heading
+
+	// CodeLines counts lines inside code blocks.
+	CodeLines() int
+
+	// Bytes returns the raw file content.
+	Bytes() []byte
+}
+
+// parse selects the parser for a path by extension. keepFrontmatter
+// leaves a markdown file's leading frontmatter block in the body; the
+// other formats have no equivalent and ignore it.
+func parse(path string, src []byte, keepFrontmatter bool) (Document, error) {
+	switch {
+	case strings.HasSuffix(path, ".rst"):
+		return rst.Parse(path, src)
+	case strings.HasSuffix(path, ".adoc"):
+		return adoc.Parse(path, src)
+	case strings.HasSuffix(path, ".ipynb"):
+		return notebook.Parse(path, src)
+	case strings.HasSuffix(path, ".html"):
+		return htmldoc.Parse(path, src)
+	}
+	if keepFrontmatter {
+		return markdown.ParseKeepFrontmatter(path, src)
+	}
+	return markdown.Parse(path, src)
+}
+
+// Status classifies a file's outcome against its thresholds.
+type Status string
+
+const (
+	StatusPass    Status = "pass"
+	StatusWarn    Status = "warn"
+	StatusFail    Status = "fail"
+	StatusSkipped Status = "skipped"
+	StatusError   Status = "error"
+)
+
+// Failure is one threshold violation on a file.
+type Failure struct {
+	// Check names the threshold that was violated, e.g. "max_grade".
+	Check string `json:"check"`
+
+	// Message is the human-readable explanation.
+	Message string `json:"message"`
+
+	// Severity is error, warning, or info; see config.Severities. Only
+	// error-level failures set StatusFail.
+	Severity string `json:"severity,omitempty"`
+
+	// Value and Limit are the measured number and the bound it broke,
+	// exposed to custom message templates; for the fail_on_* checks
+	// Value is the issue count and Limit is zero.
+	Value float64 `json:"value,omitempty"`
+	Limit float64 `json:"limit,omitempty"`
+}
+
+// Result is the analysis outcome for one file.
+type Result struct {
+	Path     string    `json:"path"`
+	Status   Status    `json:"status"`
+	Metrics  Metrics   `json:"metrics"`
+	Failures []Failure `json:"failures,omitempty"`
+
+	// SkipReason explains a skipped status, e.g. an exclude override.
+	SkipReason string `json:"skip_reason,omitempty"`
+
+	// Language is the non-English language the file was scored under,
+	// from frontmatter lang or config; see applyLanguage.
+	Language string `json:"language,omitempty"`
+
+	// Profile names the threshold profile the file was checked
+	// against, from frontmatter content-type or an override; see
+	// config.Profiles. Empty means the base thresholds.
+	Profile string `json:"profile,omitempty"`
+
+	// Sentences holds per-sentence scores when the analyzer was asked
+	// for them; see Analyzer.IncludeSentences.
+	Sentences []Sentence `json:"sentences,omitempty"`
+
+	// LongSentences lists sentences over the max_sentence_words cap,
+	// computed only when that threshold is configured.
+	LongSentences []LongSentence `json:"long_sentences,omitempty"`
+
+	// Misspellings holds unknown words when spell checking was enabled.
+	Misspellings []spell.Misspelling `json:"misspellings,omitempty"`
+
+	// WhitespaceIssues holds style violations when the whitespace
+	// check was enabled.
+	WhitespaceIssues []WhitespaceIssue `json:"whitespace_issues,omitempty"`
+
+	// DurationMS is the analysis wall time for this file in
+	// milliseconds, captured only when Analyzer.Timings is set.
+	DurationMS float64 `json:"duration_ms,omitempty"`
+
+	// Cached marks a result reported from the last run's state file
+	// rather than re-analyzed; see ResultCache.
+	Cached bool `json:"cached,omitempty"`
+
+	// EmptySections lists headings with no content and heading-only
+	// files; see CheckEmptySections.
+	EmptySections []string `json:"empty_sections,omitempty"`
+
+	// RepeatedOpenings lists runs of same-word sentence openings; see
+	// CheckRepeatedOpenings.
+	RepeatedOpenings []RepeatedOpening `json:"repeated_openings,omitempty"`
+
+	// SyntaxIssues lists markdown well-formedness problems, computed
+	// only under StrictMarkdown; see CheckMarkdownSyntax.
+	SyntaxIssues []SyntaxIssue `json:"syntax_issues,omitempty"`
+
+	// ListMarkerIssues lists bullet and ordered items whose marker
+	// deviates from the file's first, plus mix summaries; see
+	// CheckListMarkers.
+	ListMarkerIssues []string `json:"list_marker_issues,omitempty"`
+
+	// HeadingStyleIssues lists setext headings and style mixing; see
+	// CheckHeadingStyles.
+	HeadingStyleIssues []string `json:"heading_style_issues,omitempty"`
+
+	// H1Issues lists violations of the one-leading-H1 title contract:
+	// missing, duplicated, or not first; see CheckH1. Computed only
+	// when require_h1 is configured.
+	H1Issues []string `json:"h1_issues,omitempty"`
+
+	// TableIssues lists malformed GFM tables with line numbers; see
+	// CheckTables.
+	TableIssues []string `json:"table_issues,omitempty"`
+
+	// AdmonitionIssues lists malformed admonition blocks and
+	// definition lists with line numbers; see CheckAdmonitions.
+	AdmonitionIssues []string `json:"admonition_issues,omitempty"`
+
+	// SpellingVariantIssues lists US/UK spelling inconsistencies with
+	// line numbers; see CheckSpellingVariants. Computed only when
+	// fail_on_spelling_variants is configured.
+	SpellingVariantIssues []string `json:"spelling_variant_issues,omitempty"`
+
+	// TerminologyIssues lists disallowed terms with their preferred
+	// forms; see CheckTerminology.
+	TerminologyIssues []TermIssue `json:"terminology_issues,omitempty"`
+
+	// CodeBlockRuns lists stacks of unexplained code blocks over the
+	// max_consecutive_code_blocks cap, computed only when that
+	// threshold is configured.
+	CodeBlockRuns []CodeBlockRun `json:"code_block_runs,omitempty"`
+
+	// SuspectCodeBlocks lists fenced blocks whose content reads like
+	// prose, usually a missing closing fence; see CheckProseInCode.
+	SuspectCodeBlocks []SuspectCodeBlock `json:"suspect_code_blocks,omitempty"`
+
+	// LongHeadings lists headings over the max_heading_words cap,
+	// computed only when that threshold is configured.
+	LongHeadings []LongHeading `json:"long_headings,omitempty"`
+
+	// UnlabeledCodeBlocks lists fences with no language hint, computed
+	// only when fail_on_unlabeled_code is configured; see
+	// CheckUnlabeledCode.
+	UnlabeledCodeBlocks []UnlabeledCodeBlock `json:"unlabeled_code_blocks,omitempty"`
+
+	// BareURLs lists raw URLs in prose outside link syntax, computed
+	// only when fail_on_bare_urls is configured; see CheckBareURLs.
+	BareURLs []BareURL `json:"bare_urls,omitempty"`
+
+	// FirstParagraph scores the opening paragraph on its own, computed
+	// only when max_first_paragraph_grade is configured; the opening
+	// decides whether readers continue.
+	FirstParagraph *Metrics `json:"first_paragraph,omitempty"`
+
+	// Findings holds issues reported by registered custom checks.
+	Findings []Finding `json:"findings,omitempty"`
+
+	// Category is the file's frontmatter category value, populated when
+	// Analyzer.CategoryField names a key; --group-by frontmatter:<field>
+	// aggregates on it.
+	Category string `json:"category,omitempty"`
+
+	// Margins reports utilization against each configured numeric
+	// threshold; populated only when Analyzer.ReportMargins is set.
+	// See ThresholdMargins.
+	Margins []Margin `json:"threshold_margins,omitempty"`
+
+	// Borderline marks files within the warn margin of a readability
+	// limit, the ones a small edit could tip over; such files carry
+	// StatusWarn. See borderline and config.Thresholds.WarnMargin.
+	Borderline bool `json:"borderline,omitempty"`
+
+	// Error explains an errored status: the file could not be read or
+	// parsed. Such files carry zero metrics.
+	Error string `json:"error,omitempty"`
+}
+
+// Analyzer scores files and applies thresholds from its configuration.
+type Analyzer struct {
+	cfg *config.Config
+
+	// IncludeSentences populates Result.Sentences with per-sentence
+	// scores, used by the annotated output format.
+	IncludeSentences bool
+
+	// ReportMargins populates Result.Margins with per-threshold
+	// utilization, used by --report-threshold-margins.
+	ReportMargins bool
+
+	// CategoryField names a frontmatter key whose value is recorded in
+	// Result.Category, for --group-by frontmatter:<field> aggregation.
+	// Empty leaves Category unset.
+	CategoryField string
+
+	// ListItemsAsSentences terminates unpunctuated list items so each
+	// counts as its own sentence; see config.Analysis.
+	ListItemsAsSentences bool
+
+	// ExcludeBlockquotes drops blockquote content from the scored
+	// prose; see config.Analysis. Off by default: quoted text is still
+	// text the reader reads.
+	ExcludeBlockquotes bool
+
+	// SyllableOverrides corrects the syllable estimate for specific
+	// words; see config.Analysis.
+	SyllableOverrides map[string]int
+
+	// Language selects the readability formulas and syllable rules
+	// for files that do not declare their own via frontmatter lang;
+	// see config.Analysis. Empty means English.
+	Language string
+
+	// Abbreviations is the sentence-splitting abbreviation set; see
+	// SplitSentencesWith. Nil means the built-in defaults; the CLI
+	// loads domain lists via config.Analysis.AbbreviationsFile.
+	// Ignored when Splitter is set.
+	Abbreviations map[string]bool
+
+	// Splitter overrides the sentence splitter used by every
+	// sentence-based computation: the formulas' sentence counts,
+	// max_sentence_words, per-sentence scores, and repeated-opening
+	// detection. Nil means a RuleSplitter over Abbreviations; see
+	// SentenceSplitter for swapping in a smarter implementation.
+	Splitter SentenceSplitter
+
+	// Stopwords is the word set FindDuplicates drops before shingling.
+	// Nil means the built-in defaults; see
+	// config.Analysis.StopwordsFile.
+	Stopwords map[string]bool
+
+	// Spell, when set, records unknown words on Result.Misspellings.
+	Spell *spell.Checker
+
+	// Whitespace records style-guide whitespace issues on
+	// Result.WhitespaceIssues.
+	Whitespace bool
+
+	// StrictMarkdown records well-formedness problems on
+	// Result.SyntaxIssues; see CheckMarkdownSyntax.
+	StrictMarkdown bool
+
+	// Timings records per-file analysis wall time on
+	// Result.DurationMS for the slowest-files report.
+	Timings bool
+
+	// StructuralOnly skips the readability formulas and syllable
+	// counting, populating only structural metrics: words, lines, code
+	// ratio, headings, and list depth. Dramatically faster on large
+	// trees when only corpus totals are wanted; readability gates do
+	// not apply, see config.Thresholds.StructuralOnly.
+	StructuralOnly bool
+
+	// Strict makes AnalyzePath abort on the first file that fails to
+	// read or parse. By default such files become error-status results
+	// and the walk continues, so one bad file does not blank out the
+	// whole report.
+	Strict bool
+
+	// checks are the registered custom checks; see Register.
+	checks []Check
+
+	// msgTmpl renders custom failure messages; see
+	// config.MessageTemplate. Nil keeps the defaults.
+	msgTmpl *template.Template
+
+	// OnResult, when set, is called with each result as soon as its
+	// file finishes analyzing, before AnalyzePath returns. It lets the
+	// CLI stream output instead of buffering a large run. Results are
+	// still delivered in walk order today, but streaming consumers
+	// should not rely on that.
+	OnResult func(*Result)
+
+	// NoRecurse makes AnalyzePath read only a directory's immediate
+	// entries instead of walking its subtree, for checking one section
+	// without its nested subsections.
+	NoRecurse bool
+
+	// FollowSymlinks makes AnalyzePath descend into symlinked
+	// directories, for aggregated doc trees that symlink shared pages
+	// in. Directory identity is tracked along the descent, so a
+	// symlink cycle errors out instead of walking forever, and a tree
+	// linked in twice is analyzed once.
+	FollowSymlinks bool
+
+	// KeepFrontmatter leaves a markdown file's leading frontmatter
+	// block in the body, counting it as content; for files whose YAML
+	// head is user-facing (an example, a spec) rather than metadata.
+	// Frontmatter-driven switches like readability-exempt naturally do
+	// not apply when the block is not parsed as metadata.
+	KeepFrontmatter bool
+
+	// Cache, when set, serves unchanged files from the last run's
+	// results and records fresh ones for the next; see ResultCache.
+	// The caller owns loading and saving the state file.
+	Cache *ResultCache
+
+	// DiscardResults makes AnalyzePath drop each result after the
+	// OnResult callback instead of accumulating a slice, so aggregate
+	// consumers (summary counters, streaming output) stay flat in
+	// memory on very large corpora. AnalyzePath then returns nil
+	// results.
+	DiscardResults bool
+
+	// Log receives analysis decisions: discovered and skipped files,
+	// per-file timing. Defaults to a discarding logger; the CLI routes
+	// it to stderr under -v so JSON stdout stays clean.
+	Log *slog.Logger
+}
+
+// New returns an Analyzer using cfg, or the default configuration when
+// cfg is nil.
+func New(cfg *config.Config) *Analyzer {
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	a := &Analyzer{
+		cfg:                  cfg,
+		ListItemsAsSentences: cfg.Analysis.ListItemsAsSentences,
+		ExcludeBlockquotes:   cfg.Analysis.ExcludeBlockquotes,
+		SyllableOverrides:    cfg.Analysis.SyllableOverrides,
+		Language:             normalizeLang(cfg.Analysis.Language),
+		Log:                  slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+	// config.Load already rejected an unparsable template; one built
+	// programmatically with a bad template just keeps the defaults.
+	if cfg.MessageTemplate != "" {
+		if tmpl, err := template.New("message").Parse(cfg.MessageTemplate); err == nil {
+			a.msgTmpl = tmpl
+		}
+	}
+	return a
+}
+
+// AnalyzePath analyzes root, which may be a single file, a directory
+// walked recursively for markdown files, or a release archive (see
+// AnalyzeArchive). Results come back sorted by path. Today the walk
+// happens to produce that order; the final sort makes it a contract,
+// so diffs, baselines, and golden files stay stable if analysis ever
+// runs concurrently.
+func (a *Analyzer) AnalyzePath(root string) ([]*Result, error) {
+	// Archive entries come back in archive order, which tars and zips
+	// built by different tools do not agree on; sorting keeps the
+	// contract uniform across input kinds.
+	if IsArchive(root) {
+		results, err := a.AnalyzeArchive(root)
+		if err != nil {
+			return nil, err
+		}
+		sortResults(results)
+		return results, nil
+	}
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		r, err := a.fileResult(root)
+		if err != nil {
+			return nil, err
+		}
+		a.emit(r)
+		if a.DiscardResults {
+			return nil, nil
+		}
+		return []*Result{r}, nil
+	}
+
+	if a.NoRecurse {
+		results, err := a.analyzeDirShallow(root)
+		sortResults(results)
+		return results, err
+	}
+	if a.FollowSymlinks {
+		var results []*Result
+		w := symlinkWalk{seen: map[string]bool{}, onStack: map[string]bool{}}
+		if err := a.walkSymlinks(root, &w, &results); err != nil {
+			return nil, err
+		}
+		sortResults(results)
+		return results, nil
+	}
+
+	var results []*Result
+	ign := &ignoreScopes{}
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			ign.enter(path)
+			if path != root && ign.ignored(path) {
+				a.Log.Debug("skipping directory", "path", path, "reason", IgnoreFile)
+				return fs.SkipDir
+			}
+			return nil
+		}
+		ign.prune(path)
+		if ign.ignored(path) {
+			a.Log.Debug("skipping file", "path", path, "reason", IgnoreFile)
+			return nil
+		}
+		if !analyzable(path) {
+			a.Log.Debug("skipping file", "path", path, "reason", "unsupported extension")
+			return nil
+		}
+		a.Log.Debug("discovered file", "path", path)
+		r, err := a.fileResult(path)
+		if err != nil {
+			return err
+		}
+		a.emit(r)
+		if !a.DiscardResults {
+			results = append(results, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sortResults(results)
+	return results, nil
+}
+
+// sortResults orders results by path, the ordering AnalyzePath
+// guarantees its callers.
+func sortResults(results []*Result) {
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Path < results[j].Path })
+}
+
+// symlinkWalk tracks directory identity during a FollowSymlinks walk:
+// onStack holds the current descent for cycle detection, seen every
+// directory ever entered so shared trees analyze once. Directories are
+// keyed by their fully resolved path, which identifies the underlying
+// inode without platform-specific stat fields.
+type symlinkWalk struct {
+	seen    map[string]bool
+	onStack map[string]bool
+	ignores ignoreScopes
+}
+
+// walkSymlinks recursively analyzes dir, following symlinked
+// directories; see Analyzer.FollowSymlinks.
+func (a *Analyzer) walkSymlinks(dir string, w *symlinkWalk, results *[]*Result) error {
+	real, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+	if w.onStack[real] {
+		return fmt.Errorf("symlink loop detected: %s resolves to %s, which is still being walked", dir, real)
+	}
+	if w.seen[real] {
+		a.Log.Debug("skipping directory", "path", dir, "reason", "already analyzed via another link")
+		return nil
+	}
+	w.seen[real] = true
+	w.onStack[real] = true
+	defer delete(w.onStack, real)
+
+	// Ignore files stack down the descent and unwind with it. Scopes
+	// key on the link path walked, not the resolved one, so a linked-in
+	// tree is governed by ignore files along the path actually taken.
+	depth := len(w.ignores.scopes)
+	w.ignores.scopes = append(w.ignores.scopes, loadIgnoreScope(dir))
+	defer func() { w.ignores.scopes = w.ignores.scopes[:depth] }()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+		if w.ignores.ignored(path) {
+			a.Log.Debug("skipping entry", "path", path, "reason", IgnoreFile)
+			continue
+		}
+		isDir := e.IsDir()
+		if e.Type()&fs.ModeSymlink != 0 {
+			info, err := os.Stat(path)
+			if err != nil {
+				if a.Strict {
+					return err
+				}
+				a.Log.Info("broken symlink", "path", path, "error", err)
+				continue
+			}
+			isDir = info.IsDir()
+		}
+		if isDir {
+			if err := a.walkSymlinks(path, w, results); err != nil {
+				return err
+			}
+			continue
+		}
+		if !analyzable(path) {
+			a.Log.Debug("skipping file", "path", path, "reason", "unsupported extension")
+			continue
+		}
+		a.Log.Debug("discovered file", "path", path)
+		r, err := a.fileResult(path)
+		if err != nil {
+			return err
+		}
+		a.emit(r)
+		if !a.DiscardResults {
+			*results = append(*results, r)
+		}
+	}
+	return nil
+}
+
+// analyzeDirShallow is the NoRecurse counterpart of the AnalyzePath
+// walk: only root's immediate entries, subdirectories skipped.
+func (a *Analyzer) analyzeDirShallow(root string) ([]*Result, error) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return nil, err
+	}
+	var results []*Result
+	ign := ignoreScopes{scopes: []ignoreScope{loadIgnoreScope(root)}}
+	for _, e := range entries {
+		path := filepath.Join(root, e.Name())
+		if e.IsDir() {
+			a.Log.Debug("skipping directory", "path", path, "reason", "no-recurse")
+			continue
+		}
+		if ign.ignored(path) {
+			a.Log.Debug("skipping file", "path", path, "reason", IgnoreFile)
+			continue
+		}
+		if !analyzable(path) {
+			a.Log.Debug("skipping file", "path", path, "reason", "unsupported extension")
+			continue
+		}
+		a.Log.Debug("discovered file", "path", path)
+		r, err := a.fileResult(path)
+		if err != nil {
+			return nil, err
+		}
+		a.emit(r)
+		if !a.DiscardResults {
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+// fileResult wraps AnalyzeFile for the walk: unless Strict is set, a
+// read or parse failure becomes an error-status result instead of
+// aborting.
+func (a *Analyzer) fileResult(path string) (*Result, error) {
+	r, err := a.AnalyzeFile(path)
+	if err == nil {
+		return r, nil
+	}
+	if a.Strict {
+		return nil, err
+	}
+	a.Log.Info("file errored", "path", path, "error", err)
+	return &Result{Path: path, Status: StatusError, Error: err.Error()}, nil
+}
+
+// emit hands a finished result to the OnResult callback, if any.
+func (a *Analyzer) emit(r *Result) {
+	if a.OnResult != nil {
+		a.OnResult(r)
+	}
+}
+
+// AnalyzeFile reads, parses, and scores a single markdown file, then
+// checks it against the thresholds resolved for its path.
+func (a *Analyzer) AnalyzeFile(path string) (*Result, error) {
+	// The size guard runs on the stat, before the file is ever read:
+	// a runaway generated file should cost nothing but a directory
+	// entry lookup.
+	if max := a.cfg.MaxFileBytes; max > 0 {
+		if info, err := os.Stat(path); err == nil && info.Size() > max {
+			return a.oversizeResult(path, info.Size()), nil
+		}
+	}
+	var cacheInfo fs.FileInfo
+	if a.Cache != nil {
+		if info, err := os.Stat(path); err == nil {
+			if r, ok := a.Cache.Get(path, info); ok {
+				a.Log.Debug("cached result", "path", path, "status", r.Status)
+				return r, nil
+			}
+			cacheInfo = info
+		}
+	}
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	start := time.Now()
+	res, err := a.Analyze(path, src)
+	if err == nil {
+		elapsed := time.Since(start)
+		if a.Timings {
+			res.DurationMS = float64(elapsed.Microseconds()) / 1000
+		}
+		if cacheInfo != nil {
+			a.Cache.Put(path, cacheInfo, res)
+		}
+		a.Log.Debug("analyzed file", "path", path,
+			"status", res.Status, "duration", elapsed)
+	}
+	return res, err
+}
+
+// oversizeResult builds the result for a file the MaxFileBytes guard
+// rejected, per the configured OversizeAction: skipped with the size
+// as the reason, or failed.
+func (a *Analyzer) oversizeResult(path string, size int64) *Result {
+	res := &Result{Path: path}
+	msg := fmt.Sprintf("file size %d bytes exceeds max_file_bytes %d", size, a.cfg.MaxFileBytes)
+	if a.cfg.OversizeAction == config.OversizeFail {
+		res.Status = StatusFail
+		res.Failures = append(res.Failures, Failure{
+			Check:    "max_file_bytes",
+			Message:  msg,
+			Severity: config.SeverityError,
+			Value:    float64(size),
+			Limit:    float64(a.cfg.MaxFileBytes),
+		})
+	} else {
+		res.Status = StatusSkipped
+		res.SkipReason = msg
+	}
+	a.Log.Info("oversize file", "path", path, "bytes", size, "action", res.Status)
+	return res
+}
+
+// Analyze scores src as the content of path. Excluded paths come back
+// skipped with zero metrics.
+func (a *Analyzer) Analyze(path string, src []byte) (*Result, error) {
+	// A .md extension on binary or mis-encoded content happens with bad
+	// commits; scoring the bytes would produce garbage metrics, so such
+	// files error with a reason instead.
+	if !utf8.Valid(src) {
+		return nil, fmt.Errorf("%s: content is not valid UTF-8 (binary file?)", path)
+	}
+	// CRLF files must score identically to their LF equivalents; the
+	// line-based checks below all split on \n.
+	src = markdown.NormalizeNewlines(src)
+	res := &Result{Path: path, Status: StatusPass}
+
+	thresholds, profile, excluded := a.cfg.ForPathProfile(path)
+	if excluded {
+		res.Status = StatusSkipped
+		res.SkipReason = "excluded by config override"
+		a.Log.Info("skipping file", "path", path, "reason", res.SkipReason)
+		return res, nil
+	}
+	if a.cfg.SkipFilename(path) {
+		res.Status = StatusSkipped
+		res.SkipReason = "filename in skip_filenames"
+		a.Log.Info("skipping file", "path", path, "reason", res.SkipReason)
+		return res, nil
+	}
+
+	doc, err := parse(path, src, a.KeepFrontmatter)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason := exemptReason(doc); reason != "" {
+		res.Status = StatusSkipped
+		res.SkipReason = reason
+		a.Log.Info("skipping file", "path", path, "reason", reason)
+		return res, nil
+	}
+
+	// An opening --- with no closing delimiter is almost always a typo;
+	// the content still parses as body, but silently would hide the
+	// mistake. Surfaced as a warning so it never blocks CI on its own.
+	if md, ok := doc.(*markdown.Document); ok && md.FrontmatterUnclosed {
+		res.Failures = append(res.Failures, Failure{
+			Check:    "frontmatter_unclosed",
+			Message:  "frontmatter opens with --- but never closes; treating the block as content",
+			Severity: config.SeverityWarning,
+		})
+		res.Status = StatusWarn
+		a.Log.Info("unclosed frontmatter", "path", path)
+	}
+
+	// Frontmatter content-type picks a threshold profile directly,
+	// replacing whatever the path rules resolved: the page knows its
+	// own content type better than a directory prefix does.
+	if name := docProfile(doc); name != "" {
+		if t, ok := a.cfg.ProfileThresholds(name); ok {
+			thresholds = t
+			profile = name
+		} else {
+			a.Log.Info("unknown profile", "path", path, "profile", name)
+		}
+	}
+	res.Profile = profile
+	if a.CategoryField != "" {
+		res.Category = docCategory(doc, a.CategoryField)
+	}
+
+	// Frontmatter lang wins over the configured default, so a handful
+	// of translated pages can sit in an otherwise English tree.
+	lang := docLanguage(doc)
+	if lang == "" {
+		lang = a.Language
+	}
+	if lang != "" && lang != LangEnglish {
+		res.Language = lang
+	}
+
+	prose := a.prose(doc)
+	if a.StructuralOnly {
+		res.Metrics.Words = len(strings.Fields(prose))
+		thresholds = thresholds.StructuralOnly()
+	} else {
+		res.Metrics = ScoreText(prose, a.scoreOptions(lang))
+		res.Metrics.GradeLabel = GradeLabel(res.Metrics.FleschKincaid, a.cfg.Analysis.GradeLabels)
+	}
+	res.Metrics.Lines = countLines(src)
+	res.Metrics.CodeLines = doc.CodeLines()
+	for _, h := range doc.Headings() {
+		if h.Level >= 1 && h.Level <= 6 {
+			res.Metrics.HeadingsByLevel[h.Level-1]++
+		}
+	}
+	res.Metrics.MaxListDepth, res.Metrics.MaxListDepthLine = MaxListDepth(src)
+	res.Metrics.ScrollWeight = ScrollWeight(src)
+	if res.Metrics.Lines > 0 {
+		res.Metrics.CodeRatio = float64(res.Metrics.CodeLines) / float64(res.Metrics.Lines)
+	}
+	headings := 0
+	for _, n := range res.Metrics.HeadingsByLevel {
+		headings += n
+	}
+	res.Metrics.ReadingTimeMinutes = ReadingTimeMinutes(res.Metrics.Words, res.Metrics.CodeLines, headings, a.cfg.Analysis.ReadingRates)
+	if a.IncludeSentences && !a.StructuralOnly {
+		res.Sentences = ScoreSentencesWith(prose, a.scoreOptions(lang))
+		loc := NewLocator(src)
+		for i := range res.Sentences {
+			s := &res.Sentences[i]
+			if start, end, line, ok := loc.Locate(s.Text); ok {
+				s.Start, s.End, s.Line = start, end, line
+			}
+		}
+	}
+	if max := thresholds.MaxSentenceWords; max != nil && *max > 0 && !a.StructuralOnly {
+		res.LongSentences = CheckLongSentencesWith(prose, a.splitter(), *max)
+		loc := NewLocator(src)
+		for i := range res.LongSentences {
+			s := &res.LongSentences[i]
+			if start, end, line, ok := loc.Locate(s.Text); ok {
+				s.Start, s.End, s.Line = start, end, line
+			}
+		}
+	}
+	if a.Spell != nil {
+		res.Misspellings = a.Spell.Check(src)
+	}
+	if a.Whitespace {
+		res.WhitespaceIssues = CheckWhitespace(src)
+	}
+	if a.StrictMarkdown {
+		res.SyntaxIssues = CheckMarkdownSyntax(src)
+	}
+	res.EmptySections = CheckEmptySections(src)
+	res.HeadingStyleIssues = CheckHeadingStyles(doc)
+	res.ListMarkerIssues = CheckListMarkers(src)
+	if thresholds.RequireH1 != nil && *thresholds.RequireH1 {
+		res.H1Issues = CheckH1(doc)
+	}
+	res.TableIssues = CheckTables(src)
+	res.AdmonitionIssues = CheckAdmonitions(src)
+	if on := thresholds.FailOnSpellingVariants; on != nil && *on {
+		res.SpellingVariantIssues = CheckSpellingVariants(src, a.cfg.Analysis.SpellingVariant)
+	}
+	res.TerminologyIssues = CheckTerminology(src, a.cfg.Terminology)
+	res.SuspectCodeBlocks = CheckProseInCode(src)
+	if max := thresholds.MaxConsecutiveCodeBlocks; max != nil && *max > 0 {
+		res.CodeBlockRuns = CheckCodeBlockRuns(src, *max)
+	}
+	if max := thresholds.MaxHeadingWords; max != nil && *max > 0 {
+		res.LongHeadings = CheckLongHeadings(src, *max)
+	}
+	if thresholds.FailOnBareURLs != nil && *thresholds.FailOnBareURLs {
+		res.BareURLs = CheckBareURLs(src)
+	}
+	if thresholds.FailOnUnlabeledCode != nil && *thresholds.FailOnUnlabeledCode {
+		res.UnlabeledCodeBlocks = CheckUnlabeledCode(src)
+	}
+	if max := thresholds.MaxFirstParagraphGrade; max != nil && *max > 0 && !a.StructuralOnly {
+		if first := firstParagraph(doc); first != "" {
+			m := ScoreText(first, a.scoreOptions(lang))
+			res.FirstParagraph = &m
+		}
+	}
+	minRun := a.cfg.Analysis.RepeatedOpeningRun
+	if minRun == 0 {
+		minRun = 3
+	}
+	res.RepeatedOpenings = CheckRepeatedOpenings(src, a.splitter().Split(prose), minRun)
+	for _, c := range a.checks {
+		for _, finding := range c.Run(doc, res) {
+			if finding.Check == "" {
+				finding.Check = c.Name()
+			}
+			res.Findings = append(res.Findings, finding)
+		}
+	}
+
+	if a.ReportMargins {
+		res.Margins = ThresholdMargins(res.Metrics, thresholds)
+	}
+	a.check(res, thresholds)
+	return res, nil
+}
+
+// scoreOptions bundles the analyzer's scoring knobs for ScoreText,
+// with lang already resolved per file.
+func (a *Analyzer) scoreOptions(lang string) ScoreOptions {
+	norm := a.cfg.Analysis.NormalizeSmartQuotes
+	emoji := a.cfg.Analysis.StripEmoji
+	return ScoreOptions{
+		Language:          lang,
+		SyllableOverrides: a.SyllableOverrides,
+		Abbreviations:     a.Abbreviations,
+		Splitter:          a.Splitter,
+		NoNormalize:       norm != nil && !*norm,
+		KeepEmoji:         emoji != nil && !*emoji,
+	}
+}
+
+// splitter resolves the analyzer's effective sentence splitter.
+func (a *Analyzer) splitter() SentenceSplitter {
+	if a.Splitter != nil {
+		return a.Splitter
+	}
+	return RuleSplitter{Abbreviations: a.Abbreviations}
+}
+
+// exemptReason checks a document's frontmatter for the sanctioned
+// opt-out: either readability-exempt: true or analyzer: {skip: true}.
+// Exempt files report as skipped rather than pass/fail, which keeps
+// the exemption visible and reviewable in the page itself.
+func exemptReason(doc Document) string {
+	fm, ok := doc.(interface{ Meta() map[string]any })
+	if !ok {
+		return ""
+	}
+	meta := fm.Meta()
+	if meta == nil {
+		return ""
+	}
+	if v, ok := meta["readability-exempt"].(bool); ok && v {
+		return "frontmatter readability-exempt"
+	}
+	if sub, ok := meta["analyzer"].(map[string]any); ok {
+		if v, ok := sub["skip"].(bool); ok && v {
+			return "frontmatter analyzer.skip"
+		}
+	}
+	return ""
+}
+
+// docProfile reads a document's frontmatter content-type key, the
+// per-file way to select a threshold profile.
+func docProfile(doc Document) string {
+	fm, ok := doc.(interface{ Meta() map[string]any })
+	if !ok {
+		return ""
+	}
+	if v, ok := fm.Meta()["content-type"].(string); ok {
+		return strings.TrimSpace(v)
+	}
+	return ""
+}
+
+// docCategory reads a frontmatter field's value for category grouping.
+// A string value is taken as is; a list takes its first string entry,
+// so multi-category posts land in one group rather than several.
+func docCategory(doc Document, field string) string {
+	fm, ok := doc.(interface{ Meta() map[string]any })
+	if !ok {
+		return ""
+	}
+	switch v := fm.Meta()[field].(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				return strings.TrimSpace(s)
+			}
+		}
+	}
+	return ""
+}
+
+// Prose parses src as the content of path and returns the text the
+// analyzer would score, for tooling that inspects words rather than
+// whole-file metrics.
+func (a *Analyzer) Prose(path string, src []byte) (string, error) {
+	doc, err := parse(path, src, a.KeepFrontmatter)
+	if err != nil {
+		return "", err
+	}
+	return a.prose(doc), nil
+}
+
+// firstParagraph returns the text of a document's first paragraph
+// block, skipping headings and list items; empty when the file has no
+// paragraph prose at all.
+func firstParagraph(doc Document) string {
+	for _, b := range doc.Blocks() {
+		if b.Kind == markdown.BlockParagraph {
+			return b.Text
+		}
+	}
+	return ""
+}
+
+// ExtractProse parses src as the content of path and returns the exact
+// prose string the readability formulas would score, after frontmatter,
+// code, and markup stripping. It exists for the --dump-prose debugging
+// aid; scoring itself goes through Analyze.
+func (a *Analyzer) ExtractProse(path string, src []byte) (string, error) {
+	doc, err := parse(path, src, a.KeepFrontmatter)
+	if err != nil {
+		return "", err
+	}
+	return a.prose(doc), nil
+}
+
+// prose assembles the text to score from a document's blocks. With
+// ListItemsAsSentences, items lacking terminal punctuation get one, so
+// the sentence splitter sees each item as a sentence instead of letting
+// a whole list collapse into a single run-on. With ExcludeBlockquotes,
+// quoted blocks are dropped before scoring.
+func (a *Analyzer) prose(doc Document) string {
+	blocks := doc.Blocks()
+	texts := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		if a.ExcludeBlockquotes && b.Kind == markdown.BlockBlockquote {
+			continue
+		}
+		text := b.Text
+		if a.ListItemsAsSentences && b.Kind == markdown.BlockListItem && !strings.HasSuffix(text, ".") &&
+			!strings.HasSuffix(text, "!") && !strings.HasSuffix(text, "?") {
+			text += "."
+		}
+		texts = append(texts, text)
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// check applies thresholds to a scored result. Each violation takes
+// its configured severity; only error-level ones fail the file, so
+// teams can keep softer checks visible without blocking CI.
+func (a *Analyzer) check(res *Result, t config.Thresholds) {
+	for _, f := range Evaluate(res, t) {
+		f.Severity = a.cfg.SeverityFor(f.Check)
+		if a.msgTmpl != nil {
+			f.Message = a.renderMessage(res.Path, f)
+		}
+		res.Failures = append(res.Failures, f)
+		if f.Severity == config.SeverityError {
+			res.Status = StatusFail
+		} else if f.Severity == config.SeverityWarning && res.Status == StatusPass {
+			res.Status = StatusWarn
+		}
+	}
+	// Passing files inside the warn margin get the intermediate warn
+	// status: visible early warning that does not fail --check unless
+	// the CLI is asked to treat warnings strictly.
+	if res.Status == StatusPass {
+		margin := 0.10
+		if t.WarnMargin != nil {
+			margin = *t.WarnMargin
+		}
+		if margin > 0 && borderline(res.Metrics, t, margin) {
+			res.Borderline = true
+			res.Status = StatusWarn
+		}
+	}
+}
+
+// renderMessage runs the configured message template for one failure,
+// so teams can point CI output at their own remediation docs. A
+// template that errors at execution falls back to the default message.
+func (a *Analyzer) renderMessage(path string, f Failure) string {
+	var b strings.Builder
+	err := a.msgTmpl.Execute(&b, struct {
+		File    string
+		Check   string
+		Value   float64
+		Limit   float64
+		Message string
+	}{path, f.Check, f.Value, f.Limit, f.Message})
+	if err != nil {
+		a.Log.Info("message template failed", "path", path, "check", f.Check, "error", err)
+		return f.Message
+	}
+	return b.String()
+}
+
+// borderline reports whether any readability metric sits within margin
+// (a fraction, 0.10 for 10%) of its configured limit: still passing,
+// but one dense paragraph away from failing.
+func borderline(m Metrics, t config.Thresholds, margin float64) bool {
+	frac := 1 - margin
+	if t.MaxLines != nil && *t.MaxLines > 0 && float64(m.Lines) > float64(*t.MaxLines)*frac {
+		return true
+	}
+	// Below the word floor Evaluate skips the formula gates entirely,
+	// so a near-limit score there can never become a failure; warning
+	// about it would flag exactly the low-prose pages the floor exists
+	// to protect.
+	if t.MinWords != nil && m.Words < *t.MinWords {
+		return false
+	}
+	over := func(v float64, limit *float64) bool {
+		return limit != nil && *limit > 0 && v > *limit*frac
+	}
+	if over(m.FleschKincaid, t.MaxGrade) || over(m.ARI, t.MaxARI) ||
+		over(m.GunningFog, t.MaxFog) || over(m.ColemanLiau, t.MaxColemanLiau) ||
+		over(m.SMOG, t.MaxSMOG) {
+		return true
+	}
+	return t.MinEase != nil && *t.MinEase > 0 && m.FleschEase < *t.MinEase/frac
+}
+
+// Evaluate returns the threshold violations a scored result has under
+// t, without changing the result. The run's own thresholds and any
+// hypothetical ones go through the same comparisons. Files below the
+// word floor skip readability checks entirely: formulas are
+// meaningless on code-heavy pages with little prose. Line limits
+// always apply.
+func Evaluate(res *Result, t config.Thresholds) []Failure {
+	var out []Failure
+	fail := func(check string, value, limit float64, message string) {
+		out = append(out, Failure{Check: check, Message: message, Value: value, Limit: limit})
+	}
+	m := res.Metrics
+
+	if t.MaxLines != nil && m.Lines > *t.MaxLines {
+		fail("max_lines", float64(m.Lines), float64(*t.MaxLines),
+			fmt.Sprintf("%d lines exceeds limit %d", m.Lines, *t.MaxLines))
+	}
+	if t.MaxScrollWeight != nil && *t.MaxScrollWeight > 0 && m.ScrollWeight > *t.MaxScrollWeight {
+		fail("max_scroll_weight", float64(m.ScrollWeight), float64(*t.MaxScrollWeight),
+			fmt.Sprintf("scroll weight %d exceeds limit %d (%d lines plus image and code-block weight)",
+				m.ScrollWeight, *t.MaxScrollWeight, m.Lines))
+	}
+	if t.MaxCodeRatio != nil && m.CodeRatio > *t.MaxCodeRatio {
+		fail("max_code_ratio", m.CodeRatio, *t.MaxCodeRatio,
+			fmt.Sprintf("code ratio %.0f%% exceeds limit %.0f%%", m.CodeRatio*100, *t.MaxCodeRatio*100))
+	}
+	if t.MaxListDepth != nil && m.MaxListDepth > *t.MaxListDepth {
+		fail("max_list_depth", float64(m.MaxListDepth), float64(*t.MaxListDepth),
+			fmt.Sprintf("list nesting depth %d at line %d exceeds limit %d",
+				m.MaxListDepth, m.MaxListDepthLine, *t.MaxListDepth))
+	}
+	if t.MinHeadingDensity != nil && *t.MinHeadingDensity > 0 {
+		if need := m.Lines / *t.MinHeadingDensity; m.HeadingsByLevel[1] < need {
+			fail("min_heading_density", float64(m.HeadingsByLevel[1]), float64(need),
+				fmt.Sprintf("%d H2 headings over %d lines; want at least one per %d lines",
+					m.HeadingsByLevel[1], m.Lines, *t.MinHeadingDensity))
+		}
+	}
+	if t.MinProseWords != nil && m.Words < *t.MinProseWords {
+		fail("min_prose_words", float64(m.Words), float64(*t.MinProseWords),
+			fmt.Sprintf("%d prose words below limit %d", m.Words, *t.MinProseWords))
+	}
+	if t.MaxSentenceWords != nil && *t.MaxSentenceWords > 0 && len(res.LongSentences) > 0 {
+		longest := 0
+		for _, s := range res.LongSentences {
+			if s.Words > longest {
+				longest = s.Words
+			}
+		}
+		fail("max_sentence_words", float64(longest), float64(*t.MaxSentenceWords),
+			fmt.Sprintf("%d sentences exceed %d words (longest %d)",
+				len(res.LongSentences), *t.MaxSentenceWords, longest))
+	}
+	if t.MaxFirstParagraphGrade != nil && *t.MaxFirstParagraphGrade > 0 && res.FirstParagraph != nil &&
+		res.FirstParagraph.FleschKincaid > *t.MaxFirstParagraphGrade {
+		fail("max_first_paragraph_grade", res.FirstParagraph.FleschKincaid, *t.MaxFirstParagraphGrade,
+			fmt.Sprintf("first paragraph grade %.1f exceeds limit %.1f",
+				res.FirstParagraph.FleschKincaid, *t.MaxFirstParagraphGrade))
+	}
+	if t.MaxMetricSpread != nil && *t.MaxMetricSpread > 0 && m.MetricSpread > *t.MaxMetricSpread {
+		fail("max_metric_spread", m.MetricSpread, *t.MaxMetricSpread,
+			fmt.Sprintf("grade formulas disagree by %.1f, more than limit %.1f", m.MetricSpread, *t.MaxMetricSpread))
+	}
+	if t.FailOnSpelling != nil && *t.FailOnSpelling && len(res.Misspellings) > 0 {
+		fail("fail_on_spelling", float64(len(res.Misspellings)), 0,
+			fmt.Sprintf("%d unknown words", len(res.Misspellings)))
+	}
+	if t.FailOnSpellingVariants != nil && *t.FailOnSpellingVariants && len(res.SpellingVariantIssues) > 0 {
+		fail("fail_on_spelling_variants", float64(len(res.SpellingVariantIssues)), 0,
+			fmt.Sprintf("%d US/UK spelling inconsistencies", len(res.SpellingVariantIssues)))
+	}
+	if t.FailOnMarkdownSyntax != nil && *t.FailOnMarkdownSyntax && len(res.SyntaxIssues) > 0 {
+		fail("fail_on_markdown_syntax", float64(len(res.SyntaxIssues)), 0,
+			fmt.Sprintf("%d markdown syntax issues", len(res.SyntaxIssues)))
+	}
+	if t.FailOnWhitespace != nil && *t.FailOnWhitespace && len(res.WhitespaceIssues) > 0 {
+		fail("fail_on_whitespace", float64(len(res.WhitespaceIssues)), 0,
+			fmt.Sprintf("%d whitespace issues", len(res.WhitespaceIssues)))
+	}
+	if t.FailOnEmptySections != nil && *t.FailOnEmptySections && len(res.EmptySections) > 0 {
+		fail("fail_on_empty_sections", float64(len(res.EmptySections)), 0,
+			fmt.Sprintf("%d empty sections", len(res.EmptySections)))
+	}
+	if t.FailOnRepeatedOpenings != nil && *t.FailOnRepeatedOpenings && len(res.RepeatedOpenings) > 0 {
+		fail("fail_on_repeated_openings", float64(len(res.RepeatedOpenings)), 0,
+			fmt.Sprintf("%d repeated-opening runs", len(res.RepeatedOpenings)))
+	}
+	if t.FailOnHeadingStyle != nil && *t.FailOnHeadingStyle && len(res.HeadingStyleIssues) > 0 {
+		fail("fail_on_heading_style", float64(len(res.HeadingStyleIssues)), 0,
+			fmt.Sprintf("%d heading style issues", len(res.HeadingStyleIssues)))
+	}
+	if t.FailOnMixedListMarkers != nil && *t.FailOnMixedListMarkers && len(res.ListMarkerIssues) > 0 {
+		fail("fail_on_mixed_list_markers", float64(len(res.ListMarkerIssues)), 0,
+			fmt.Sprintf("%d list marker issues", len(res.ListMarkerIssues)))
+	}
+	if t.RequireH1 != nil && *t.RequireH1 && len(res.H1Issues) > 0 {
+		fail("require_h1", float64(len(res.H1Issues)), 0,
+			fmt.Sprintf("%d H1 title issues", len(res.H1Issues)))
+	}
+	if t.FailOnBrokenTables != nil && *t.FailOnBrokenTables && len(res.TableIssues) > 0 {
+		fail("fail_on_broken_tables", float64(len(res.TableIssues)), 0,
+			fmt.Sprintf("%d malformed tables", len(res.TableIssues)))
+	}
+	if t.FailOnBrokenAdmonitions != nil && *t.FailOnBrokenAdmonitions && len(res.AdmonitionIssues) > 0 {
+		fail("fail_on_broken_admonitions", float64(len(res.AdmonitionIssues)), 0,
+			fmt.Sprintf("%d malformed admonitions or definition lists", len(res.AdmonitionIssues)))
+	}
+	if t.MaxConsecutiveCodeBlocks != nil && *t.MaxConsecutiveCodeBlocks > 0 && len(res.CodeBlockRuns) > 0 {
+		longest := 0
+		for _, r := range res.CodeBlockRuns {
+			if r.Count > longest {
+				longest = r.Count
+			}
+		}
+		fail("max_consecutive_code_blocks", float64(longest), float64(*t.MaxConsecutiveCodeBlocks),
+			fmt.Sprintf("%d consecutive code blocks exceeds limit %d", longest, *t.MaxConsecutiveCodeBlocks))
+	}
+	if t.FailOnTerminology != nil && *t.FailOnTerminology && len(res.TerminologyIssues) > 0 {
+		fail("fail_on_terminology", float64(len(res.TerminologyIssues)), 0,
+			fmt.Sprintf("%d disallowed terms", len(res.TerminologyIssues)))
+	}
+	if t.MaxHeadingWords != nil && *t.MaxHeadingWords > 0 && len(res.LongHeadings) > 0 {
+		longest := 0
+		for _, h := range res.LongHeadings {
+			if h.Words > longest {
+				longest = h.Words
+			}
+		}
+		fail("max_heading_words", float64(longest), float64(*t.MaxHeadingWords),
+			fmt.Sprintf("heading of %d words exceeds limit %d", longest, *t.MaxHeadingWords))
+	}
+	if t.FailOnProseInCode != nil && *t.FailOnProseInCode && len(res.SuspectCodeBlocks) > 0 {
+		fail("fail_on_prose_in_code", float64(len(res.SuspectCodeBlocks)), 0,
+			fmt.Sprintf("%d code blocks look like prose", len(res.SuspectCodeBlocks)))
+	}
+	if t.FailOnUnlabeledCode != nil && *t.FailOnUnlabeledCode && len(res.UnlabeledCodeBlocks) > 0 {
+		fail("fail_on_unlabeled_code", float64(len(res.UnlabeledCodeBlocks)), 0,
+			fmt.Sprintf("%d code blocks declare no language", len(res.UnlabeledCodeBlocks)))
+	}
+	if t.FailOnBareURLs != nil && *t.FailOnBareURLs && len(res.BareURLs) > 0 {
+		fail("fail_on_bare_urls", float64(len(res.BareURLs)), 0,
+			fmt.Sprintf("%d bare URLs outside link syntax", len(res.BareURLs)))
+	}
+
+	if t.MinWords != nil && m.Words < *t.MinWords {
+		return out
+	}
+
+	if t.MaxGrade != nil && m.FleschKincaid > *t.MaxGrade {
+		fail("max_grade", m.FleschKincaid, *t.MaxGrade,
+			fmt.Sprintf("grade %.1f exceeds limit %.1f", m.FleschKincaid, *t.MaxGrade))
+	}
+	if t.MaxARI != nil && m.ARI > *t.MaxARI {
+		fail("max_ari", m.ARI, *t.MaxARI,
+			fmt.Sprintf("ARI %.1f exceeds limit %.1f", m.ARI, *t.MaxARI))
+	}
+	if t.MaxFog != nil && m.GunningFog > *t.MaxFog {
+		fail("max_fog", m.GunningFog, *t.MaxFog,
+			fmt.Sprintf("fog %.1f exceeds limit %.1f", m.GunningFog, *t.MaxFog))
+	}
+	if t.MinEase != nil && m.FleschEase < *t.MinEase {
+		fail("min_ease", m.FleschEase, *t.MinEase,
+			fmt.Sprintf("ease %.1f below limit %.1f", m.FleschEase, *t.MinEase))
+	}
+	if t.MaxColemanLiau != nil && m.ColemanLiau > *t.MaxColemanLiau {
+		fail("max_coleman_liau", m.ColemanLiau, *t.MaxColemanLiau,
+			fmt.Sprintf("Coleman-Liau %.1f exceeds limit %.1f", m.ColemanLiau, *t.MaxColemanLiau))
+	}
+	if t.MaxSMOG != nil && m.SMOG > *t.MaxSMOG {
+		fail("max_smog", m.SMOG, *t.MaxSMOG,
+			fmt.Sprintf("SMOG %.1f exceeds limit %.1f", m.SMOG, *t.MaxSMOG))
+	}
+	return out
+}
+
+// countLines counts lines the way editors display them: a trailing
+// newline does not start an empty extra line.
+func countLines(src []byte) int {
+	if len(src) == 0 {
+		return 0
+	}
+	n := bytes.Count(src, []byte("\n"))
+	if src[len(src)-1] != '\n' {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,465 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+const listHeavyPage = `# Reference
+
+Options supported by the deployment pipeline configuration
+
+- namespace selection with label matching across environments
+- credential rotation schedules for the signing service
+- artifact retention windows measured in calendar days
+- notification routing for failed verification runs
+- concurrency limits applied to parallel build stages
+`
+
+func TestFrontmatterExemption(t *testing.T) {
+	src := []byte("---\ntitle: Legal Notice\nreadability-exempt: true\n---\n# Legal Notice\n\nDense legalese.\n")
+	res, err := New(nil).Analyze("legal.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusSkipped {
+		t.Errorf("Status = %s, want skipped", res.Status)
+	}
+	if res.SkipReason != "frontmatter readability-exempt" {
+		t.Errorf("SkipReason = %q", res.SkipReason)
+	}
+
+	src = []byte("---\nanalyzer:\n  skip: true\n---\nBody.\n")
+	res, err = New(nil).Analyze("skip.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusSkipped {
+		t.Errorf("analyzer.skip: Status = %s, want skipped", res.Status)
+	}
+}
+
+func TestListItemsAsSentences(t *testing.T) {
+	plain := New(nil)
+	plainRes, err := plain.Analyze("reference.md", []byte(listHeavyPage))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Default()
+	cfg.Analysis.ListItemsAsSentences = true
+	split := New(cfg)
+	splitRes, err := split.Analyze("reference.md", []byte(listHeavyPage))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if plainRes.Metrics.Sentences != 1 {
+		t.Errorf("without option: Sentences = %d, want 1 (whole page is one run-on)",
+			plainRes.Metrics.Sentences)
+	}
+	if splitRes.Metrics.Sentences < 5 {
+		t.Errorf("with option: Sentences = %d, want at least one per list item",
+			splitRes.Metrics.Sentences)
+	}
+	if splitRes.Metrics.FleschKincaid >= plainRes.Metrics.FleschKincaid {
+		t.Errorf("with option: grade %.1f did not drop from %.1f",
+			splitRes.Metrics.FleschKincaid, plainRes.Metrics.FleschKincaid)
+	}
+}
+
+func TestCategoryField(t *testing.T) {
+	a := New(nil)
+	a.CategoryField = "categories"
+	src := []byte("---\ncategories:\n  - DevSecOps\n  - Tutorials\n---\n\n# T\n\nProse.\n")
+	res, err := a.Analyze("post.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Category != "DevSecOps" {
+		t.Errorf("Category = %q, want first list entry %q", res.Category, "DevSecOps")
+	}
+
+	scalar, err := a.Analyze("page.md", []byte("---\ncategories: reference\n---\n\n# T\n\nProse.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scalar.Category != "reference" {
+		t.Errorf("scalar Category = %q, want %q", scalar.Category, "reference")
+	}
+}
+
+func TestAnalyzeInvalidUTF8(t *testing.T) {
+	binary := []byte{'#', ' ', 'T', '\n', 0xff, 0xfe, 0x00, 0x80, 0x81}
+	if _, err := New(nil).Analyze("broken.md", binary); err == nil ||
+		!strings.Contains(err.Error(), "not valid UTF-8") {
+		t.Errorf("Analyze on binary content: err = %v, want UTF-8 validity error", err)
+	}
+
+	// Through the directory walk the file must error, not crash or
+	// score, and must not take the run down with it.
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "broken.md"), binary, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "fine.md"), []byte("# OK\n\nReadable prose here.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	results, err := New(nil).AnalyzePath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("AnalyzePath = %d results, want 2", len(results))
+	}
+	if results[0].Status != StatusError || !strings.Contains(results[0].Error, "not valid UTF-8") {
+		t.Errorf("broken.md: status %s, error %q; want error status with UTF-8 reason",
+			results[0].Status, results[0].Error)
+	}
+	if results[1].Status == StatusError {
+		t.Errorf("fine.md errored: %s", results[1].Error)
+	}
+}
+
+func TestExcludeBlockquotes(t *testing.T) {
+	src := []byte("# Title\n\nPlain prose outside the quote.\n\n> Dense quoted boilerplate that the team cannot edit.\n")
+
+	with, err := New(nil).Prose("quoted.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(with, "boilerplate") {
+		t.Errorf("default prose dropped the blockquote: %q", with)
+	}
+
+	cfg := config.Default()
+	cfg.Analysis.ExcludeBlockquotes = true
+	without, err := New(cfg).Prose("quoted.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(without, "boilerplate") {
+		t.Errorf("exclude_blockquotes kept the blockquote: %q", without)
+	}
+	if !strings.Contains(without, "Plain prose") {
+		t.Errorf("exclude_blockquotes dropped non-quoted prose: %q", without)
+	}
+}
+
+type bannedWordCheck struct{ word string }
+
+func (c bannedWordCheck) Name() string { return "banned_word" }
+
+func (c bannedWordCheck) Run(doc Document, res *Result) []Finding {
+	if strings.Contains(string(doc.Bytes()), c.word) {
+		return []Finding{{Message: "uses banned word " + c.word}}
+	}
+	return nil
+}
+
+func TestRegisteredCheck(t *testing.T) {
+	a := New(config.Default())
+	a.Register(bannedWordCheck{word: "simply"})
+	res, err := a.Analyze("docs/page.md", []byte("# Page\n\nYou simply run the tool.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Findings) != 1 {
+		t.Fatalf("got %d findings, want 1", len(res.Findings))
+	}
+	if res.Findings[0].Check != "banned_word" {
+		t.Errorf("check = %q, want banned_word", res.Findings[0].Check)
+	}
+}
+
+func TestWarningSeverityDoesNotFail(t *testing.T) {
+	cfg := config.Default()
+	cfg.Severities = map[string]string{"max_lines": config.SeverityWarning}
+	cfg.Thresholds.MaxLines = i(1)
+	a := New(cfg)
+	res, err := a.Analyze("docs/page.md", []byte("# Page\n\nTwo lines of prose here.\nAnd one more for good measure.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusWarn {
+		t.Errorf("Status = %s, want warn: warnings surface without failing", res.Status)
+	}
+	if len(res.Failures) != 1 || res.Failures[0].Severity != config.SeverityWarning {
+		t.Errorf("Failures = %+v, want one warning", res.Failures)
+	}
+}
+
+func TestBorderlineSkipsBelowWordFloor(t *testing.T) {
+	// Dense enough to sit over every formula limit, but far below the
+	// default min_words floor that exempts it from the formula gates:
+	// a score that can never fail must not warn either.
+	src := []byte("# Note\n\nInterdepartmental organizational recalibration necessitates comprehensive multidisciplinary prioritization throughout institutional synchronization.\n")
+	res, err := New(config.Default()).Analyze("docs/short.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusPass || res.Borderline {
+		t.Errorf("Status = %s, Borderline = %v; want pass without borderline below the word floor",
+			res.Status, res.Borderline)
+	}
+}
+
+func i(v int) *int { return &v }
+
+func TestProfileFromFrontmatter(t *testing.T) {
+	cfg := config.Default()
+	cfg.Profiles = map[string]config.Thresholds{
+		"blog": {MaxLines: i(1)},
+	}
+	a := New(cfg)
+	src := []byte("---\ncontent-type: blog\n---\n\n# Post\n\nA few lines of prose.\nAnd a couple more to cross the limit.\n")
+	res, err := a.Analyze("docs/post.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Profile != "blog" {
+		t.Errorf("Profile = %q, want blog", res.Profile)
+	}
+	if res.Status != StatusFail {
+		t.Errorf("Status = %s, want fail under the blog profile's max_lines", res.Status)
+	}
+}
+
+func TestProfileFromOverridePath(t *testing.T) {
+	cfg := config.Default()
+	cfg.Profiles = map[string]config.Thresholds{
+		"reference": {MaxLines: i(1000)},
+	}
+	cfg.Overrides = []config.Override{{Path: "docs/reference", Profile: "reference"}}
+	a := New(cfg)
+	res, err := a.Analyze("docs/reference/api.md", []byte("# API\n\nShort page.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Profile != "reference" {
+		t.Errorf("Profile = %q, want reference", res.Profile)
+	}
+}
+
+func TestMaxFileBytesGuard(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "huge.md")
+	if err := os.WriteFile(path, []byte("# Huge\n\n"+strings.Repeat("word ", 100)), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := config.Default()
+	cfg.MaxFileBytes = 64
+	res, err := New(cfg).AnalyzeFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusSkipped {
+		t.Errorf("Status = %s, want skipped", res.Status)
+	}
+	if !strings.Contains(res.SkipReason, "max_file_bytes") {
+		t.Errorf("SkipReason = %q, want it to name max_file_bytes", res.SkipReason)
+	}
+
+	cfg.OversizeAction = config.OversizeFail
+	res, err = New(cfg).AnalyzeFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusFail {
+		t.Errorf("oversize_action fail: Status = %s, want fail", res.Status)
+	}
+	if len(res.Failures) != 1 || res.Failures[0].Check != "max_file_bytes" {
+		t.Errorf("Failures = %+v, want one max_file_bytes failure", res.Failures)
+	}
+}
+
+func TestMaxSentenceWordsGate(t *testing.T) {
+	cfg := config.Default()
+	cfg.Thresholds.MinWords = i(1)
+	cfg.Thresholds.MaxSentenceWords = i(10)
+	a := New(cfg)
+	src := []byte("# Page\n\nShort opener here.\n\nThis single sentence keeps going and going well past the ten word cap we configured.\n")
+	res, err := a.Analyze("docs/page.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusFail {
+		t.Errorf("Status = %s, want fail", res.Status)
+	}
+	if len(res.LongSentences) != 1 {
+		t.Fatalf("LongSentences = %+v, want exactly one", res.LongSentences)
+	}
+	if ls := res.LongSentences[0]; ls.Words <= 10 || ls.Line != 5 {
+		t.Errorf("LongSentences[0] = %+v, want >10 words at line 5", ls)
+	}
+	found := false
+	for _, f := range res.Failures {
+		if f.Check == "max_sentence_words" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Failures = %+v, want a max_sentence_words failure", res.Failures)
+	}
+}
+
+func TestNoRecurse(t *testing.T) {
+	dir := t.TempDir()
+	write := func(rel string) {
+		t.Helper()
+		path := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte("# Page\n\nShort prose.\n"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("top.md")
+	write("nested/inner.md")
+
+	a := New(nil)
+	a.NoRecurse = true
+	results, err := a.AnalyzePath(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 || filepath.Base(results[0].Path) != "top.md" {
+		t.Errorf("results = %+v, want only top.md", results)
+	}
+}
+
+func TestFollowSymlinks(t *testing.T) {
+	shared := t.TempDir()
+	if err := os.WriteFile(filepath.Join(shared, "shared.md"), []byte("# Shared\n\nLinked prose.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "own.md"), []byte("# Own\n\nLocal prose.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(shared, filepath.Join(root, "linked")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+
+	a := New(nil)
+	if results, err := a.AnalyzePath(root); err != nil {
+		t.Fatal(err)
+	} else if len(results) != 1 {
+		t.Errorf("without FollowSymlinks got %d results, want 1", len(results))
+	}
+
+	a.FollowSymlinks = true
+	results, err := a.AnalyzePath(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Errorf("with FollowSymlinks got %d results, want 2", len(results))
+	}
+}
+
+func TestFollowSymlinksLoop(t *testing.T) {
+	root := t.TempDir()
+	sub := filepath.Join(root, "sub")
+	if err := os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(root, filepath.Join(sub, "back")); err != nil {
+		t.Skipf("symlinks unavailable: %v", err)
+	}
+	a := New(nil)
+	a.FollowSymlinks = true
+	if _, err := a.AnalyzePath(root); err == nil || !strings.Contains(err.Error(), "symlink loop") {
+		t.Errorf("err = %v, want a symlink loop error", err)
+	}
+}
+
+func TestFirstParagraphGate(t *testing.T) {
+	cfg := config.Default()
+	cfg.Thresholds.MinWords = i(1)
+	grade := 5.0
+	cfg.Thresholds.MaxFirstParagraphGrade = &grade
+	a := New(cfg)
+	src := []byte("# Page\n\nNotwithstanding considerable organizational complexities, sophisticated documentation initiatives necessitate extraordinarily comprehensive preliminary elaboration.\n\nThen it gets easy.\n")
+	res, err := a.Analyze("docs/page.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.FirstParagraph == nil {
+		t.Fatal("FirstParagraph = nil, want scored opening paragraph")
+	}
+	if res.FirstParagraph.FleschKincaid <= grade {
+		t.Errorf("FirstParagraph.FleschKincaid = %.1f, want above %.1f", res.FirstParagraph.FleschKincaid, grade)
+	}
+	found := false
+	for _, f := range res.Failures {
+		if f.Check == "max_first_paragraph_grade" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Failures = %+v, want a max_first_paragraph_grade failure", res.Failures)
+	}
+}
+
+func TestAnalyzeCRLFMatchesLF(t *testing.T) {
+	lf := "# Title\n\nTwo plain sentences sit here. They score the same on any platform.\n\n```\ncode line\n```\n"
+	crlf := strings.ReplaceAll(lf, "\n", "\r\n")
+
+	a := New(config.Default())
+	lres, err := a.Analyze("lf.md", []byte(lf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cres, err := a.Analyze("crlf.md", []byte(crlf))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cres.Metrics.Lines != lres.Metrics.Lines {
+		t.Errorf("CRLF lines = %d, want %d", cres.Metrics.Lines, lres.Metrics.Lines)
+	}
+	if cres.Metrics.Characters != lres.Metrics.Characters {
+		t.Errorf("CRLF characters = %d, want %d", cres.Metrics.Characters, lres.Metrics.Characters)
+	}
+	if cres.Metrics.FleschKincaid != lres.Metrics.FleschKincaid {
+		t.Errorf("CRLF grade = %v, want %v", cres.Metrics.FleschKincaid, lres.Metrics.FleschKincaid)
+	}
+}
+
+func TestAnalyzePathSortedOrder(t *testing.T) {
+	root := t.TempDir()
+	page := "# Title\n\nSome prose for ordering.\n"
+	// Created in deliberately non-lexical order; the result order must
+	// not depend on creation order or walk internals.
+	for _, rel := range []string{"z.md", "sub/b.md", "a.md", "sub/a.md", "m.md"} {
+		path := filepath.Join(root, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(page), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	a := New(config.Default())
+	results, err := a.AnalyzePath(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("results = %d, want 5", len(results))
+	}
+	if !sort.SliceIsSorted(results, func(i, j int) bool { return results[i].Path < results[j].Path }) {
+		paths := make([]string, len(results))
+		for i, r := range results {
+			paths[i] = r.Path
+		}
+		t.Errorf("results not sorted by path: %v", paths)
+	}
+}
@@ -0,0 +1,143 @@
+package analyzer
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// IsArchive reports whether path looks like a doc bundle the analyzer
+// can read directly; see AnalyzeArchive.
+func IsArchive(path string) bool {
+	return strings.HasSuffix(path, ".zip") ||
+		strings.HasSuffix(path, ".tar.gz") ||
+		strings.HasSuffix(path, ".tgz")
+}
+
+// AnalyzeArchive analyzes the markdown entries of a .zip or .tar.gz
+// release archive without extracting it, using the in-archive path as
+// Result.Path. It lets a release gate validate a published doc bundle
+// exactly as shipped.
+func (a *Analyzer) AnalyzeArchive(path string) ([]*Result, error) {
+	switch {
+	case strings.HasSuffix(path, ".zip"):
+		return a.analyzeZip(path)
+	case strings.HasSuffix(path, ".tar.gz"), strings.HasSuffix(path, ".tgz"):
+		return a.analyzeTarGz(path)
+	}
+	return nil, fmt.Errorf("unsupported archive %s: want .zip, .tar.gz, or .tgz", path)
+}
+
+func (a *Analyzer) analyzeZip(path string) ([]*Result, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	var results []*Result
+	for _, f := range zr.File {
+		if !analyzable(f.Name) {
+			continue
+		}
+		if max := a.cfg.MaxFileBytes; max > 0 && int64(f.UncompressedSize64) > max {
+			r := a.oversizeResult(f.Name, int64(f.UncompressedSize64))
+			a.emit(r)
+			if !a.DiscardResults {
+				results = append(results, r)
+			}
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		src, err := io.ReadAll(rc)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		r, err := a.analyzeEntry(f.Name, src)
+		if err != nil {
+			return nil, err
+		}
+		if !a.DiscardResults {
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+func (a *Analyzer) analyzeTarGz(path string) ([]*Result, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var results []*Result
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg || !analyzable(hdr.Name) {
+			continue
+		}
+		if max := a.cfg.MaxFileBytes; max > 0 && hdr.Size > max {
+			r := a.oversizeResult(hdr.Name, hdr.Size)
+			a.emit(r)
+			if !a.DiscardResults {
+				results = append(results, r)
+			}
+			continue
+		}
+		src, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		r, err := a.analyzeEntry(hdr.Name, src)
+		if err != nil {
+			return nil, err
+		}
+		if !a.DiscardResults {
+			results = append(results, r)
+		}
+	}
+	return results, nil
+}
+
+func (a *Analyzer) analyzeEntry(name string, src []byte) (*Result, error) {
+	a.Log.Debug("discovered archive entry", "path", name)
+	r, err := a.Analyze(name, src)
+	if err != nil {
+		if a.Strict {
+			return nil, err
+		}
+		a.Log.Info("file errored", "path", name, "error", err)
+		r = &Result{Path: name, Status: StatusError, Error: err.Error()}
+	}
+	a.emit(r)
+	return r, nil
+}
+
+// analyzable mirrors the extension filter AnalyzePath applies when
+// walking a directory.
+func analyzable(name string) bool {
+	return strings.HasSuffix(name, ".md") || strings.HasSuffix(name, ".rst") ||
+		strings.HasSuffix(name, ".adoc") || strings.HasSuffix(name, ".ipynb") ||
+		strings.HasSuffix(name, ".html")
+}
@@ -0,0 +1,95 @@
+package analyzer
+
+import (
+	"archive/zip"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestAnalyzeArchiveZip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "docs.zip")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	for name, body := range map[string]string{
+		"docs/index.md":  "# Home\n\nWelcome to the documentation.\n",
+		"docs/logo.png":  "not markdown",
+		"docs/notes.rst": "Notes\n=====\n\nSome prose here.\n",
+	} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := New(nil).AnalyzePath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	paths := map[string]bool{}
+	for _, r := range results {
+		paths[r.Path] = true
+	}
+	if !paths["docs/index.md"] || !paths["docs/notes.rst"] {
+		t.Errorf("unexpected result paths: %v", paths)
+	}
+}
+
+func TestAnalyzeArchiveSortedOrder(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "docs.zip")
+	f, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zw := zip.NewWriter(f)
+	// Entries in deliberately non-lexical archive order; AnalyzePath
+	// promises sorted results regardless of input kind.
+	for _, name := range []string{"docs/z.md", "docs/a.md", "docs/m.md"} {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte("# Page\n\nSome prose for ordering.\n")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := New(nil).AnalyzePath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, r := range results {
+		got = append(got, r.Path)
+	}
+	want := []string{"docs/a.md", "docs/m.md", "docs/z.md"}
+	if len(got) != len(want) {
+		t.Fatalf("paths = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("paths = %v, want %v", got, want)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// BareURL is a raw URL pasted into prose without link syntax, with its
+// 1-based source line. Bare URLs render inconsistently across markdown
+// engines and read as noise; wrapping them in a link with descriptive
+// text fixes both.
+type BareURL struct {
+	Line int    `json:"line"`
+	URL  string `json:"url"`
+}
+
+// String renders the issue for listings.
+func (b BareURL) String() string {
+	return fmt.Sprintf("bare URL %s; wrap it in a link with descriptive text", b.URL)
+}
+
+// bareURLPattern matches an http(s) URL up to whitespace or a
+// delimiter that cannot be part of it.
+var bareURLPattern = regexp.MustCompile(`https?://[^\s<>()\x60]+`)
+
+// inlineCodeSpan matches single-backtick inline code for blanking
+// before the URL scan.
+var inlineCodeSpan = regexp.MustCompile("`[^`]*`")
+
+// CheckBareURLs scans raw markdown for URLs that appear outside link
+// syntax: not a link destination, not an autolink, not inside inline or
+// fenced code, and not a reference-style link definition. Trailing
+// sentence punctuation is trimmed off the reported URL.
+func CheckBareURLs(src []byte) []BareURL {
+	var out []BareURL
+	inFence := false
+	for i, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || linkDefLine.MatchString(line) {
+			continue
+		}
+		scrubbed := inlineCodeSpan.ReplaceAllStringFunc(line, func(s string) string {
+			return strings.Repeat(" ", len(s))
+		})
+		for _, loc := range bareURLPattern.FindAllStringIndex(scrubbed, -1) {
+			if loc[0] > 0 {
+				// '(' means a link destination, '<' an autolink; both
+				// are proper syntax.
+				if prev := scrubbed[loc[0]-1]; prev == '(' || prev == '<' {
+					continue
+				}
+			}
+			url := strings.TrimRight(scrubbed[loc[0]:loc[1]], ".,;:!?")
+			out = append(out, BareURL{Line: i + 1, URL: url})
+		}
+	}
+	return out
+}
+
+// linkDefLine matches reference-style link definitions, which hold a
+// raw URL by design.
+var linkDefLine = regexp.MustCompile(`^\s*\[[^\]]+\]:\s`)
@@ -0,0 +1,39 @@
+package analyzer
+
+import "testing"
+
+func TestCheckBareURLs(t *testing.T) {
+	src := []byte(`# Title
+
+See https://example.com/install for setup.
+
+Proper [link](https://example.com/ok) and autolink <https://example.com/auto> pass.
+
+[ref]: https://example.com/refdef
+
+Inline ` + "`https://example.com/code`" + ` passes too.
+
+` + "```" + `
+https://example.com/fenced
+` + "```" + `
+
+Trailing punctuation trimmed: https://example.com/page.
+`)
+	got := CheckBareURLs(src)
+	if len(got) != 2 {
+		t.Fatalf("CheckBareURLs = %+v, want 2 issues", got)
+	}
+	if got[0].Line != 3 || got[0].URL != "https://example.com/install" {
+		t.Errorf("first = %+v, want line 3 https://example.com/install", got[0])
+	}
+	if got[1].URL != "https://example.com/page" {
+		t.Errorf("second URL = %q, want trailing period trimmed", got[1].URL)
+	}
+}
+
+func TestCheckBareURLsClean(t *testing.T) {
+	src := []byte("Prose with a [good link](https://example.com) only.\n")
+	if got := CheckBareURLs(src); len(got) != 0 {
+		t.Errorf("CheckBareURLs = %+v, want none", got)
+	}
+}
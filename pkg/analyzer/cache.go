@@ -0,0 +1,102 @@
+package analyzer
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+// ResultCache remembers results between runs keyed by path, so a rerun
+// on a stable tree re-analyzes only the files that changed since the
+// analyzer last saw them. A file counts as unchanged when its size and
+// mtime both match; that misses same-size same-mtime edits, which in
+// practice means nothing a human just saved.
+type ResultCache struct {
+	// Fingerprint ties the cache to the configuration it was built
+	// under; see ConfigFingerprint. Thresholds live in config, so a
+	// config edit silently invalidates every cached verdict.
+	Fingerprint string `json:"fingerprint"`
+
+	Entries map[string]CacheEntry `json:"entries"`
+}
+
+// CacheEntry is one cached result with the file identity it was
+// computed at.
+type CacheEntry struct {
+	Size    int64   `json:"size"`
+	ModTime int64   `json:"mod_time"`
+	Result  *Result `json:"result"`
+}
+
+// ConfigFingerprint hashes a configuration for ResultCache.Fingerprint.
+func ConfigFingerprint(cfg *config.Config) string {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(data))
+}
+
+// LoadCache reads the state file at path. A missing file or a
+// fingerprint mismatch yields an empty cache rather than an error:
+// both just mean everything gets re-analyzed.
+func LoadCache(path, fingerprint string) (*ResultCache, error) {
+	empty := &ResultCache{Fingerprint: fingerprint, Entries: map[string]CacheEntry{}}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return empty, nil
+		}
+		return nil, fmt.Errorf("load state file: %w", err)
+	}
+	var c ResultCache
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("load state file %s: %w", path, err)
+	}
+	if c.Fingerprint != fingerprint || c.Entries == nil {
+		return empty, nil
+	}
+	return &c, nil
+}
+
+// Save writes the cache to path.
+func (c *ResultCache) Save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Get returns the cached result for path if the file still matches the
+// recorded size and mtime. The copy comes back with Cached set and its
+// Path reset, so later path rewriting never reaches into the cache.
+func (c *ResultCache) Get(path string, info fs.FileInfo) (*Result, bool) {
+	e, ok := c.Entries[path]
+	if !ok || e.Result == nil ||
+		e.Size != info.Size() || e.ModTime != info.ModTime().UnixNano() {
+		return nil, false
+	}
+	r := *e.Result
+	r.Path = path
+	r.Cached = true
+	return &r, true
+}
+
+// Put records a freshly computed result under the file identity info
+// describes. Error-status results are not cached; a transient read
+// failure should not survive the run that hit it.
+func (c *ResultCache) Put(path string, info fs.FileInfo, r *Result) {
+	if r.Status == StatusError {
+		return
+	}
+	c.Entries[path] = CacheEntry{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		Result:  r,
+	}
+}
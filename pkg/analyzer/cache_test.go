@@ -0,0 +1,81 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestResultCacheRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	doc := filepath.Join(dir, "a.md")
+	if err := os.WriteFile(doc, []byte("# Title\n\nPlain short prose here.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	state := filepath.Join(dir, "state.json")
+
+	a := New(nil)
+	cache, err := LoadCache(state, "fp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Cache = cache
+	first, err := a.AnalyzeFile(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Cached {
+		t.Error("first analysis reported as cached")
+	}
+	if err := cache.Save(state); err != nil {
+		t.Fatal(err)
+	}
+
+	// A second analyzer with the reloaded state serves the file from
+	// cache without re-reading it.
+	b := New(nil)
+	b.Cache, err = LoadCache(state, "fp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := b.AnalyzeFile(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !second.Cached {
+		t.Error("unchanged file was not served from cache")
+	}
+	if second.Metrics.Words != first.Metrics.Words {
+		t.Errorf("cached Words = %d, want %d", second.Metrics.Words, first.Metrics.Words)
+	}
+
+	// Touching the file invalidates its entry.
+	if err := os.Chtimes(doc, time.Now(), time.Now().Add(time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	third, err := b.AnalyzeFile(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.Cached {
+		t.Error("modified file was served from cache")
+	}
+}
+
+func TestLoadCacheFingerprintMismatch(t *testing.T) {
+	state := filepath.Join(t.TempDir(), "state.json")
+	c := &ResultCache{Fingerprint: "old", Entries: map[string]CacheEntry{
+		"a.md": {Size: 1, ModTime: 1, Result: &Result{Path: "a.md", Status: StatusPass}},
+	}}
+	if err := c.Save(state); err != nil {
+		t.Fatal(err)
+	}
+	got, err := LoadCache(state, "new")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Entries) != 0 {
+		t.Errorf("fingerprint mismatch kept %d entries, want 0", len(got.Entries))
+	}
+}
@@ -0,0 +1,76 @@
+package analyzer
+
+import "strings"
+
+// Finding is one issue a check located in a file.
+type Finding struct {
+	// Check is the name of the check that produced the finding.
+	Check string `json:"check"`
+
+	// Message describes the issue.
+	Message string `json:"message"`
+
+	// Line is the 1-based line number, zero when the finding applies
+	// to the whole file.
+	Line int `json:"line,omitempty"`
+
+	// Start and End are the finding's byte span in the original
+	// source, zero when unknown. Checks can resolve spans for prose
+	// fragments with a Locator.
+	Start int `json:"start,omitempty"`
+	End   int `json:"end,omitempty"`
+}
+
+// Check is the extension point for org-specific rules. Implementations
+// registered with Analyzer.Register run during Analyze and report
+// findings on the result, without forking the analyzer.
+type Check interface {
+	// Name identifies the check in findings and output.
+	Name() string
+
+	// Run inspects a parsed document and returns its findings. The
+	// result carries the metrics already computed for the file.
+	Run(doc Document, res *Result) []Finding
+}
+
+// Register adds a custom check to run during Analyze. Checks run in
+// registration order after the built-in analysis.
+func (a *Analyzer) Register(c Check) {
+	a.checks = append(a.checks, c)
+}
+
+// WhitespaceCheck is the built-in whitespace style check as a Check
+// implementation, for embedders composing their own check lists.
+type WhitespaceCheck struct{}
+
+// Name implements Check.
+func (WhitespaceCheck) Name() string { return "whitespace" }
+
+// Run implements Check.
+func (WhitespaceCheck) Run(doc Document, res *Result) []Finding {
+	var out []Finding
+	for _, issue := range CheckWhitespace(doc.Bytes()) {
+		out = append(out, Finding{
+			Check:   "whitespace",
+			Message: strings.ReplaceAll(issue.Kind, "_", " "),
+			Line:    issue.Line,
+		})
+	}
+	return out
+}
+
+// EmptySectionsCheck is the built-in empty-section check as a Check
+// implementation.
+type EmptySectionsCheck struct{}
+
+// Name implements Check.
+func (EmptySectionsCheck) Name() string { return "empty_sections" }
+
+// Run implements Check.
+func (EmptySectionsCheck) Run(doc Document, res *Result) []Finding {
+	var out []Finding
+	for _, section := range CheckEmptySections(doc.Bytes()) {
+		out = append(out, Finding{Check: "empty_sections", Message: section})
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CodeBlockRun is a stretch of consecutive fenced code blocks with no
+// prose between them, starting at Line.
+type CodeBlockRun struct {
+	Line  int `json:"line"`
+	Count int `json:"count"`
+}
+
+// String renders the run for issue listings.
+func (c CodeBlockRun) String() string {
+	return fmt.Sprintf("%d consecutive code blocks with no prose between them", c.Count)
+}
+
+// CheckCodeBlockRuns scans raw markdown for more than maxRun fenced
+// code blocks in a row, separated by nothing but blank lines. Stacked
+// unexplained code is the poor-tutorial pattern the prose formulas
+// miss: there is too little prose for them to judge.
+func CheckCodeBlockRuns(src []byte, maxRun int) []CodeBlockRun {
+	var out []CodeBlockRun
+	lines := strings.Split(string(src), "\n")
+	run, runStart := 0, 0
+	flush := func() {
+		if run > maxRun {
+			out = append(out, CodeBlockRun{Line: runStart, Count: run})
+		}
+		run = 0
+	}
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			if run == 0 {
+				runStart = i + 1
+			}
+			run++
+			marker := trimmed[:3]
+			for i++; i < len(lines); i++ {
+				if strings.HasPrefix(strings.TrimSpace(lines[i]), marker) {
+					break
+				}
+			}
+			continue
+		}
+		if trimmed != "" {
+			flush()
+		}
+	}
+	flush()
+	return out
+}
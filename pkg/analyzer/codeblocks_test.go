@@ -0,0 +1,24 @@
+package analyzer
+
+import "testing"
+
+func TestCheckCodeBlockRuns(t *testing.T) {
+	src := []byte("# Tutorial\n\nFirst, initialize:\n\n```bash\ninit\n```\n\n```bash\nconfigure\n```\n\n```bash\ndeploy\n```\n\nThen verify the result:\n\n```bash\nstatus\n```\n")
+	got := CheckCodeBlockRuns(src, 2)
+	if len(got) != 1 {
+		t.Fatalf("got %d runs, want 1: %+v", len(got), got)
+	}
+	if got[0].Count != 3 || got[0].Line != 5 {
+		t.Errorf("run = %+v, want 3 blocks starting at line 5", got[0])
+	}
+	if got := CheckCodeBlockRuns(src, 3); len(got) != 0 {
+		t.Errorf("limit 3: got %+v, want none", got)
+	}
+}
+
+func TestCheckCodeBlockRunsProseBreaks(t *testing.T) {
+	src := []byte("```\na\n```\n\nProse between the blocks resets the run.\n\n```\nb\n```\n")
+	if got := CheckCodeBlockRuns(src, 1); len(got) != 0 {
+		t.Errorf("got %+v, want none: prose separates the blocks", got)
+	}
+}
@@ -0,0 +1,41 @@
+package analyzer
+
+import "strings"
+
+// UnlabeledCodeBlock is a fenced code block whose info string names no
+// language, with the 1-based line of its opening fence. Unlabeled
+// fences render without syntax highlighting; blocks that are
+// intentionally plain should say so with `text`.
+type UnlabeledCodeBlock struct {
+	Line int `json:"line"`
+}
+
+// String renders the block for issue listings.
+func (u UnlabeledCodeBlock) String() string {
+	return "code fence declares no language; add one, or `text` for plain output"
+}
+
+// CheckUnlabeledCode scans raw markdown for fenced code blocks opened
+// without a language hint.
+func CheckUnlabeledCode(src []byte) []UnlabeledCodeBlock {
+	var out []UnlabeledCodeBlock
+	lines := strings.Split(string(src), "\n")
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, "```") && !strings.HasPrefix(trimmed, "~~~") {
+			continue
+		}
+		marker := trimmed[:3]
+		if info := strings.TrimSpace(strings.TrimLeft(trimmed, string(marker[0]))); info == "" {
+			out = append(out, UnlabeledCodeBlock{Line: i + 1})
+		}
+		// Skip to the closing fence so its bare marker is not counted
+		// as another unlabeled opening.
+		for i++; i < len(lines); i++ {
+			if strings.HasPrefix(strings.TrimSpace(lines[i]), marker) {
+				break
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,17 @@
+package analyzer
+
+import "testing"
+
+func TestCheckUnlabeledCode(t *testing.T) {
+	src := []byte("# Title\n\n```\nplain block\n```\n\n```bash\necho labeled\n```\n\n```text\nintentionally plain\n```\n\n~~~\ntilde block\n~~~\n")
+	got := CheckUnlabeledCode(src)
+	if len(got) != 2 {
+		t.Fatalf("CheckUnlabeledCode = %+v, want 2 issues", got)
+	}
+	if got[0].Line != 3 {
+		t.Errorf("first issue at line %d, want 3", got[0].Line)
+	}
+	if got[1].Line != 15 {
+		t.Errorf("second issue at line %d, want 15", got[1].Line)
+	}
+}
@@ -0,0 +1,56 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a.md", "One two three four.\n\nFive six.\n")
+	write("b.md", "Seven eight nine.\n")
+	write("CHANGELOG.md", "Skipped by skip_filenames.\n")
+	write("draft.md", "Skipped by the ignore file.\n")
+	write(".content-analyzerignore", "draft.md\n")
+	write("notes.txt", "not markdown, not counted at all\n")
+
+	a := New(nil)
+	c, err := a.Count(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Files != 2 {
+		t.Errorf("Files = %d, want 2", c.Files)
+	}
+	if c.Words != 9 {
+		t.Errorf("Words = %d, want 9", c.Words)
+	}
+	if c.Lines != 4 {
+		t.Errorf("Lines = %d, want 4", c.Lines)
+	}
+	if c.Skipped != 2 {
+		t.Errorf("Skipped = %d, want 2", c.Skipped)
+	}
+}
+
+func TestCountSingleFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "a.md")
+	if err := os.WriteFile(path, []byte("One two three.\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	a := New(nil)
+	c, err := a.Count(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Files != 1 || c.Words != 3 || c.Lines != 1 {
+		t.Errorf("Count = %+v, want 1 file, 3 words, 1 line", c)
+	}
+}
@@ -0,0 +1,59 @@
+package analyzer
+
+import (
+	"sort"
+	"strings"
+)
+
+// AnalyzeDiff analyzes only the added lines of a unified diff, one
+// result per touched file. Each file's added lines are joined and
+// scored as a fragment, so thresholds judge the new prose alone: an
+// author who lightly edits a legacy page is not penalized for the rest
+// of it. Files whose diff touches nothing analyzable are skipped; the
+// caller supplies the diff (normally git diff --unified=0 output).
+func (a *Analyzer) AnalyzeDiff(diff []byte) ([]*Result, error) {
+	added := addedLines(diff)
+	paths := make([]string, 0, len(added))
+	for p := range added {
+		if analyzable(p) {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+
+	var results []*Result
+	for _, p := range paths {
+		src := []byte(strings.Join(added[p], "\n") + "\n")
+		res, err := a.Analyze(p, src)
+		if err != nil {
+			if a.Strict {
+				return nil, err
+			}
+			res = &Result{Path: p, Status: StatusError, Error: err.Error()}
+		}
+		results = append(results, res)
+	}
+	return results, nil
+}
+
+// addedLines parses a unified diff into the added line text per file,
+// keyed on the post-image path. Deleted files have no post-image and
+// are ignored.
+func addedLines(diff []byte) map[string][]string {
+	added := map[string][]string{}
+	current := ""
+	for _, line := range strings.Split(string(diff), "\n") {
+		switch {
+		case strings.HasPrefix(line, "+++ "):
+			name := strings.TrimPrefix(line[4:], "b/")
+			if name == "/dev/null" {
+				current = ""
+				continue
+			}
+			current = name
+		case strings.HasPrefix(line, "+") && current != "":
+			added[current] = append(added[current], line[1:])
+		}
+	}
+	return added
+}
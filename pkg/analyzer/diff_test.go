@@ -0,0 +1,59 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+const sampleDiff = `diff --git a/docs/guide.md b/docs/guide.md
+index 1111111..2222222 100644
+--- a/docs/guide.md
++++ b/docs/guide.md
+@@ -10,0 +11,2 @@
++This sentence was added in the pull request.
++So was this one, and only these two should score.
+diff --git a/main.go b/main.go
+index 3333333..4444444 100644
+--- a/main.go
++++ b/main.go
+@@ -1,0 +2 @@
++package main
+diff --git a/docs/old.md b/docs/old.md
+deleted file mode 100644
+--- a/docs/old.md
++++ /dev/null
+@@ -1,3 +0,0 @@
+-Gone.
+`
+
+func TestAddedLines(t *testing.T) {
+	added := addedLines([]byte(sampleDiff))
+	if len(added["docs/guide.md"]) != 2 {
+		t.Errorf("docs/guide.md added lines = %d, want 2", len(added["docs/guide.md"]))
+	}
+	if len(added["main.go"]) != 1 {
+		t.Errorf("main.go added lines = %d, want 1", len(added["main.go"]))
+	}
+	if _, ok := added["/dev/null"]; ok {
+		t.Error("deleted file recorded under /dev/null")
+	}
+}
+
+func TestAnalyzeDiff(t *testing.T) {
+	a := New(&config.Config{})
+	results, err := a.AnalyzeDiff([]byte(sampleDiff))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 1 {
+		t.Fatalf("results = %d, want 1 (only the markdown file)", len(results))
+	}
+	r := results[0]
+	if r.Path != "docs/guide.md" {
+		t.Errorf("path = %q, want docs/guide.md", r.Path)
+	}
+	if r.Metrics.Sentences != 2 {
+		t.Errorf("sentences = %d, want 2 from the added lines alone", r.Metrics.Sentences)
+	}
+}
@@ -0,0 +1,195 @@
+package analyzer
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Shingling and MinHash parameters: word 5-grams are long enough that
+// shared boilerplate sentences match while ordinary phrases do not,
+// and 64 hashes put the similarity estimate within a few percent.
+const (
+	shingleSize   = 5
+	signatureSize = 64
+)
+
+// DuplicateCluster is a set of files sharing near-duplicate content.
+type DuplicateCluster struct {
+	// Files are the cluster members, sorted.
+	Files []string `json:"files"`
+
+	// Similarity is the highest estimated Jaccard similarity among
+	// the pairs that linked the cluster, 0-1.
+	Similarity float64 `json:"similarity"`
+}
+
+// FindDuplicates walks root and clusters files whose prose is at least
+// threshold similar (estimated Jaccard over word shingles, 0-1).
+// Copy-pasted boilerplate shows up as clusters worth consolidating;
+// per-file metrics never see it.
+func (a *Analyzer) FindDuplicates(root string, threshold float64) ([]DuplicateCluster, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("duplicate detection needs a directory, got %s", root)
+	}
+
+	var paths []string
+	var sigs [][signatureSize]uint64
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !analyzable(path) {
+			return err
+		}
+		src, err := os.ReadFile(path)
+		if err != nil {
+			if a.Strict {
+				return err
+			}
+			a.Log.Info("file errored", "path", path, "error", err)
+			return nil
+		}
+		prose, err := a.Prose(path, src)
+		if err != nil {
+			if a.Strict {
+				return err
+			}
+			a.Log.Info("file errored", "path", path, "error", err)
+			return nil
+		}
+		shingles := shingle(prose, a.Stopwords)
+		if len(shingles) == 0 {
+			return nil
+		}
+		paths = append(paths, path)
+		sigs = append(sigs, signature(shingles))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Union-find over pairs above the threshold. Corpora are a few
+	// thousand files at most, so the quadratic pass is fine.
+	parent := make([]int, len(paths))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	best := make(map[int]float64)
+	for i := 0; i < len(paths); i++ {
+		for j := i + 1; j < len(paths); j++ {
+			sim := similarity(sigs[i], sigs[j])
+			if sim < threshold {
+				continue
+			}
+			ri, rj := find(i), find(j)
+			if ri != rj {
+				parent[rj] = ri
+			}
+			r := find(i)
+			if sim > best[r] {
+				best[r] = sim
+			}
+		}
+	}
+
+	members := make(map[int][]string)
+	for i := range paths {
+		r := find(i)
+		members[r] = append(members[r], paths[i])
+	}
+	var out []DuplicateCluster
+	for r, files := range members {
+		if len(files) < 2 {
+			continue
+		}
+		sort.Strings(files)
+		out = append(out, DuplicateCluster{Files: files, Similarity: best[r]})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Similarity != out[j].Similarity {
+			return out[i].Similarity > out[j].Similarity
+		}
+		return out[i].Files[0] < out[j].Files[0]
+	})
+	return out, nil
+}
+
+// shingle hashes each run of shingleSize consecutive lowercase words,
+// after dropping stopwords so matches hinge on distinctive words
+// rather than glue every page shares. Nil means the built-in stopword
+// defaults.
+func shingle(prose string, stopwords map[string]bool) map[uint64]struct{} {
+	if stopwords == nil {
+		stopwords = defaultStopwordSet
+	}
+	all := strings.Fields(strings.ToLower(prose))
+	words := all[:0]
+	for _, w := range all {
+		if !stopwords[w] {
+			words = append(words, w)
+		}
+	}
+	out := make(map[uint64]struct{})
+	for i := 0; i+shingleSize <= len(words); i++ {
+		h := fnv.New64a()
+		for _, w := range words[i : i+shingleSize] {
+			h.Write([]byte(w))
+			h.Write([]byte{' '})
+		}
+		out[h.Sum64()] = struct{}{}
+	}
+	return out
+}
+
+// signature computes a MinHash signature: for each of signatureSize
+// hash functions, the minimum over all shingles.
+func signature(shingles map[uint64]struct{}) [signatureSize]uint64 {
+	var sig [signatureSize]uint64
+	for i := range sig {
+		sig[i] = math.MaxUint64
+	}
+	for s := range shingles {
+		for i := range sig {
+			if h := mix(s, uint64(i)); h < sig[i] {
+				sig[i] = h
+			}
+		}
+	}
+	return sig
+}
+
+// similarity estimates Jaccard similarity as the share of signature
+// positions two files agree on.
+func similarity(a, b [signatureSize]uint64) float64 {
+	match := 0
+	for i := range a {
+		if a[i] == b[i] {
+			match++
+		}
+	}
+	return float64(match) / signatureSize
+}
+
+// mix is a splitmix64-style finalizer keying a base hash by seed, so
+// one shingle hash yields signatureSize independent hash functions.
+func mix(x, seed uint64) uint64 {
+	x += (seed + 1) * 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
@@ -0,0 +1,48 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	boiler := "# Page\n\nBefore you begin, install the CLI and authenticate against the control plane. " +
+		strings.Repeat("Every request must carry a short-lived token issued by the broker service. ", 5)
+	dir := t.TempDir()
+	write := func(name, content string) {
+		t.Helper()
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("a.md", boiler+"\nExtra sentence about page A.\n")
+	write("b.md", boiler+"\nA different closing sentence for page B.\n")
+	write("c.md", "# Other\n\nThis page shares nothing with the first two and talks about release pipelines, "+
+		"artifact signing, and promotion gates in entirely different words throughout its body.\n")
+
+	a := New(nil)
+	clusters, err := a.FindDuplicates(dir, 0.6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clusters) != 1 {
+		t.Fatalf("got %d clusters, want 1: %+v", len(clusters), clusters)
+	}
+	c := clusters[0]
+	if len(c.Files) != 2 ||
+		filepath.Base(c.Files[0]) != "a.md" || filepath.Base(c.Files[1]) != "b.md" {
+		t.Errorf("cluster files = %v, want a.md and b.md", c.Files)
+	}
+	if c.Similarity < 0.6 {
+		t.Errorf("Similarity = %.2f, want >= 0.6", c.Similarity)
+	}
+}
+
+func TestSimilarityIdentical(t *testing.T) {
+	s := shingle("one two three four five six seven eight nine ten", nil)
+	if sim := similarity(signature(s), signature(s)); sim != 1 {
+		t.Errorf("similarity of identical sets = %.2f, want 1", sim)
+	}
+}
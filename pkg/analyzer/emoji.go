@@ -0,0 +1,46 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+)
+
+// shortcodePattern matches an emoji shortcode like :rocket: or
+// :thumbs_up:. At least one non-digit is required so clock times such
+// as 12:30:45 are left alone; the purely numeric shortcodes that rule
+// excludes (:100:) are rare enough to accept.
+var shortcodePattern = regexp.MustCompile(`:[a-z0-9_+-]*[a-z_+-][a-z0-9_+-]*:`)
+
+// StripEmoji removes emoji characters and :shortcode: sequences from
+// prose. Emoji are neither words nor letters, but the formulas count
+// them as both — a heading full of rocket emoji shifts characters per
+// word and a shortcode counts as a polysyllabic word — so the same
+// prose scores differently with and without its decoration. Scoring
+// strips them by default; see config.Analysis.StripEmoji.
+func StripEmoji(prose string) string {
+	prose = shortcodePattern.ReplaceAllString(prose, " ")
+	return strings.Map(func(r rune) rune {
+		if isEmojiRune(r) {
+			return -1
+		}
+		return r
+	}, prose)
+}
+
+// isEmojiRune reports whether r sits in the blocks emoji and their
+// combining machinery come from: pictographs, symbols, dingbats,
+// regional indicators, the variation selector, and the zero-width
+// joiner that glues sequences together.
+func isEmojiRune(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF: // pictographs, symbols, flags
+		return true
+	case r >= 0x2600 && r <= 0x27BF: // misc symbols and dingbats
+		return true
+	case r >= 0x2B00 && r <= 0x2BFF: // misc symbols and arrows
+		return true
+	case r == 0xFE0F || r == 0x200D: // variation selector, ZWJ
+		return true
+	}
+	return false
+}
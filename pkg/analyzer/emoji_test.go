@@ -0,0 +1,52 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestStripEmoji(t *testing.T) {
+	got := StripEmoji("Deploy \U0001F680 the :rocket: service ✅ now.")
+	want := "Deploy  the   service  now."
+	if got != want {
+		t.Errorf("StripEmoji = %q, want %q", got, want)
+	}
+	if times := StripEmoji("The job runs at 12:30:45 daily."); !strings.Contains(times, "12:30:45") {
+		t.Errorf("StripEmoji mangled a clock time: %q", times)
+	}
+}
+
+func TestEmojiDoesNotChangeScores(t *testing.T) {
+	plain := []byte("# Getting Started\n\nInstall the tool and run the setup command before the first deploy.\n")
+	decorated := []byte("# Getting Started \U0001F680✨\n\nInstall the tool and run the setup command before the first deploy. :tada:\n")
+
+	a := New(nil)
+	plainRes, err := a.Analyze("plain.md", plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoRes, err := a.Analyze("decorated.md", decorated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plainRes.Metrics.Words != decoRes.Metrics.Words {
+		t.Errorf("words differ with emoji: %d vs %d", plainRes.Metrics.Words, decoRes.Metrics.Words)
+	}
+	if plainRes.Metrics.FleschKincaid != decoRes.Metrics.FleschKincaid {
+		t.Errorf("grade differs with emoji: %.2f vs %.2f",
+			plainRes.Metrics.FleschKincaid, decoRes.Metrics.FleschKincaid)
+	}
+
+	keep := false
+	cfg := config.Default()
+	cfg.Analysis.StripEmoji = &keep
+	kept, err := New(cfg).Analyze("decorated.md", decorated)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kept.Metrics.Words == plainRes.Metrics.Words {
+		t.Errorf("strip_emoji: false still stripped; words = %d", kept.Metrics.Words)
+	}
+}
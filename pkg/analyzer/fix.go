@@ -0,0 +1,71 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Fix kinds beyond the whitespace ones, reported by Fix.
+const (
+	SmartTypography = "smart_typography"
+	MissingAltText  = "missing_alt_text"
+)
+
+var emptyAltImage = regexp.MustCompile(`!\[\]\(`)
+
+// Fix rewrites the mechanical, unambiguous issues in raw markdown and
+// reports how many lines each category touched: trailing whitespace
+// stripped, runs of blank lines collapsed to one, smart typography
+// folded to ASCII, and empty image alt text stubbed for a human to
+// fill in. Code fences keep their blank lines and typography; prose
+// wording is never changed.
+func Fix(src []byte) ([]byte, map[string]int) {
+	counts := map[string]int{}
+	lines := strings.Split(string(src), "\n")
+	var out []string
+	inFence := false
+	blank := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			blank = false
+			out = append(out, line)
+			continue
+		}
+
+		if stripped := strings.TrimRight(line, " \t"); stripped != line {
+			// Blank-but-indented lines are not style violations (see
+			// CheckWhitespace), but normalizing them costs nothing.
+			if trimmed != "" {
+				counts[TrailingWhitespace]++
+			}
+			line = stripped
+		}
+
+		if !inFence {
+			if trimmed == "" {
+				if blank {
+					counts[ConsecutiveBlankLines]++
+					continue
+				}
+				blank = true
+				out = append(out, line)
+				continue
+			}
+			blank = false
+			if folded := NormalizeTypography(line); folded != line {
+				counts[SmartTypography]++
+				line = folded
+			}
+			if fixed := emptyAltImage.ReplaceAllString(line, "![TODO: describe image]("); fixed != line {
+				counts[MissingAltText] += len(emptyAltImage.FindAllString(line, -1))
+				line = fixed
+			}
+		} else {
+			blank = false
+		}
+		out = append(out, line)
+	}
+	return []byte(strings.Join(out, "\n")), counts
+}
@@ -0,0 +1,52 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFix(t *testing.T) {
+	src := []byte("# Title  \n\n\n\nSome “smart” prose here.\n\n![](chart.png)\n\n```\ncode  \n\n\nstays\n```\n")
+	out, counts := Fix(src)
+	text := string(out)
+
+	if counts[TrailingWhitespace] != 2 {
+		t.Errorf("TrailingWhitespace = %d, want 2 (heading and fenced line)", counts[TrailingWhitespace])
+	}
+	if counts[ConsecutiveBlankLines] != 2 {
+		t.Errorf("ConsecutiveBlankLines = %d, want 2", counts[ConsecutiveBlankLines])
+	}
+	if counts[SmartTypography] != 1 {
+		t.Errorf("SmartTypography = %d, want 1", counts[SmartTypography])
+	}
+	if counts[MissingAltText] != 1 {
+		t.Errorf("MissingAltText = %d, want 1", counts[MissingAltText])
+	}
+
+	if strings.Contains(text, "  \n") {
+		t.Error("trailing whitespace survived the fix")
+	}
+	if strings.Contains(text, "\n\n\n") && !strings.Contains(text, "code") {
+		t.Error("blank-line run survived outside a fence")
+	}
+	if !strings.Contains(text, `Some "smart" prose here.`) {
+		t.Errorf("smart quotes not folded: %q", text)
+	}
+	if !strings.Contains(text, "![TODO: describe image](chart.png)") {
+		t.Errorf("empty alt text not stubbed: %q", text)
+	}
+	if !strings.Contains(text, "code\n\n\nstays") {
+		t.Errorf("fence interior was rewritten: %q", text)
+	}
+}
+
+func TestFixCleanFileUnchanged(t *testing.T) {
+	src := []byte("# Title\n\nPlain prose with nothing to fix.\n")
+	out, counts := Fix(src)
+	if len(counts) != 0 {
+		t.Errorf("counts = %v, want none", counts)
+	}
+	if string(out) != string(src) {
+		t.Errorf("clean file rewritten: %q", out)
+	}
+}
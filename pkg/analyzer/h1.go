@@ -0,0 +1,64 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+// CheckH1 verifies the basic title contract: exactly one H1, and it
+// comes before every other heading. Pages that open with an H2 or
+// carry several H1s navigate poorly and confuse search indexing. Line
+// numbers come from the source heading scan where the parser provides
+// one; other formats report without them.
+func CheckH1(doc Document) []string {
+	hs := doc.Headings()
+	if len(hs) == 0 {
+		return []string{"no H1 title: file has no headings"}
+	}
+
+	lines := h1Lines(doc)
+	at := func(i int) string {
+		if i < len(lines) && lines[i] > 0 {
+			return fmt.Sprintf(" at line %d", lines[i])
+		}
+		return ""
+	}
+
+	var out []string
+	if hs[0].Level != 1 {
+		out = append(out, fmt.Sprintf("first heading is an H%d (%q)%s; expected an H1 title",
+			hs[0].Level, hs[0].Text, at(0)))
+	}
+	var h1s []string
+	for i, h := range hs {
+		if h.Level == 1 {
+			h1s = append(h1s, fmt.Sprintf("%q%s", h.Text, at(i)))
+		}
+	}
+	switch {
+	case len(h1s) == 0:
+		out = append(out, "no H1 title")
+	case len(h1s) > 1:
+		out = append(out, fmt.Sprintf("%d H1 headings (%s); expected exactly one",
+			len(h1s), strings.Join(h1s, ", ")))
+	}
+	return out
+}
+
+// h1Lines maps heading index to source line for parsers that track
+// written styles; nil when the format does not.
+func h1Lines(doc Document) []int {
+	styled, ok := doc.(interface {
+		HeadingStyles() []markdown.HeadingStyle
+	})
+	if !ok {
+		return nil
+	}
+	var lines []int
+	for _, h := range styled.HeadingStyles() {
+		lines = append(lines, h.Line)
+	}
+	return lines
+}
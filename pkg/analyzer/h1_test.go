@@ -0,0 +1,63 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckH1(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "single leading h1",
+			src:  "# Title\n\n## Section\n\nProse.\n",
+			want: nil,
+		},
+		{
+			name: "no headings",
+			src:  "Just prose, no structure.\n",
+			want: []string{"no H1 title"},
+		},
+		{
+			name: "starts with h2",
+			src:  "## Section First\n\nProse.\n",
+			want: []string{"first heading is an H2", "no H1 title"},
+		},
+		{
+			name: "duplicate h1",
+			src:  "# One\n\nProse.\n\n# Two\n\nMore.\n",
+			want: []string{"2 H1 headings"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			doc, err := parse("doc.md", []byte(tc.src), false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := CheckH1(doc)
+			if len(got) != len(tc.want) {
+				t.Fatalf("got %d issues, want %d: %v", len(got), len(tc.want), got)
+			}
+			for i, w := range tc.want {
+				if !strings.Contains(got[i], w) {
+					t.Errorf("issue %d = %q, want it to mention %q", i, got[i], w)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckH1LineNumbers(t *testing.T) {
+	doc, err := parse("doc.md", []byte("## Section First\n\nProse.\n"), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := CheckH1(doc)
+	if len(got) == 0 || !strings.Contains(got[0], "line 1") {
+		t.Errorf("issues = %v, want the first to carry line 1", got)
+	}
+}
@@ -0,0 +1,34 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+// CheckHeadingStyles flags setext (underlined) headings, which the
+// style guide forbids in favor of ATX, and notes when a file mixes the
+// two styles. Documents whose parser does not track heading styles
+// (reStructuredText, where underlines are the native form) report
+// nothing.
+func CheckHeadingStyles(doc Document) []string {
+	styled, ok := doc.(interface{ HeadingStyles() []markdown.HeadingStyle })
+	if !ok {
+		return nil
+	}
+	var out []string
+	atx, setext := 0, 0
+	for _, h := range styled.HeadingStyles() {
+		switch h.Style {
+		case markdown.StyleSetext:
+			setext++
+			out = append(out, fmt.Sprintf("setext heading at line %d; use ATX (%s)", h.Line, "#"))
+		default:
+			atx++
+		}
+	}
+	if atx > 0 && setext > 0 {
+		out = append(out, fmt.Sprintf("mixed heading styles: %d ATX, %d setext", atx, setext))
+	}
+	return out
+}
@@ -0,0 +1,75 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestCheckHeadingStyles(t *testing.T) {
+	src := []byte(`Title
+=====
+
+# Intro
+
+Section
+-------
+
+Prose under the section so it is not an empty stub.
+`)
+	doc, err := parse("doc.md", src, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := CheckHeadingStyles(doc)
+	want := []string{
+		"setext heading at line 1; use ATX (#)",
+		"setext heading at line 6; use ATX (#)",
+		"mixed heading styles: 1 ATX, 2 setext",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("issue %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCheckHeadingStylesIgnoresFencesAndFrontmatter(t *testing.T) {
+	src := []byte("---\ntitle: Page\n---\n# Page\n\n```\nunderlined\n----------\n```\n\nProse here.\n")
+	doc, err := parse("doc.md", src, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := CheckHeadingStyles(doc); len(got) != 0 {
+		t.Errorf("got %v, want none", got)
+	}
+}
+
+func TestCheckHeadingStylesSkipsRST(t *testing.T) {
+	src := []byte("Title\n=====\n\nSome reStructuredText prose.\n")
+	doc, err := parse("doc.rst", src, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := CheckHeadingStyles(doc); len(got) != 0 {
+		t.Errorf("got %v, want none: underlines are native in rst", got)
+	}
+}
+
+func TestFailOnHeadingStyle(t *testing.T) {
+	cfg := config.Default()
+	on := true
+	cfg.Thresholds.FailOnHeadingStyle = &on
+	a := New(cfg)
+	res, err := a.Analyze("doc.md", []byte("Title\n=====\n\n"+strings.Repeat("Plain readable prose sentence here. ", 60)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != StatusFail {
+		t.Fatalf("status = %s, want fail", res.Status)
+	}
+}
@@ -0,0 +1,62 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LongHeading is a heading whose text runs past the max_heading_words
+// cap; full-sentence headings scan poorly and overflow tables of
+// contents.
+type LongHeading struct {
+	Line  int    `json:"line"`
+	Words int    `json:"words"`
+	Text  string `json:"text"`
+}
+
+// String renders the heading for issue listings.
+func (h LongHeading) String() string {
+	return fmt.Sprintf("heading %q has %d words", h.Text, h.Words)
+}
+
+// CheckLongHeadings scans raw markdown for ATX and setext headings with
+// more than maxWords words. Fenced code and frontmatter are skipped.
+func CheckLongHeadings(src []byte, maxWords int) []LongHeading {
+	var out []LongHeading
+	flag := func(line int, text string) {
+		if words := len(strings.Fields(text)); words > maxWords {
+			out = append(out, LongHeading{Line: line, Words: words, Text: text})
+		}
+	}
+	lines := strings.Split(string(src), "\n")
+	inFence := false
+	i := 0
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		for i = 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == "---" {
+				i++
+				break
+			}
+		}
+	}
+	for ; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if rest := strings.TrimLeft(trimmed, "#"); rest != trimmed && strings.HasPrefix(rest, " ") {
+			flag(i+1, strings.TrimSpace(strings.TrimRight(rest, " #")))
+			continue
+		}
+		if trimmed != "" && (strings.Trim(trimmed, "=") == "" || strings.Trim(trimmed, "-") == "") && i > 0 {
+			if prev := strings.TrimSpace(lines[i-1]); prev != "" && !strings.HasPrefix(prev, "- ") {
+				flag(i, prev)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,37 @@
+package analyzer
+
+import "testing"
+
+func TestCheckLongHeadings(t *testing.T) {
+	src := []byte(`---
+title: Front matter stays out
+---
+
+# Setup
+
+## This heading is a whole sentence explaining what the section covers
+
+Short prose.
+
+A Setext Title That Also Runs Much Too Long For Scanning
+--------------------------------------------------------
+
+` + "```\n# not a heading, just a shell comment with many words in it\n```\n")
+	got := CheckLongHeadings(src, 6)
+	if len(got) != 2 {
+		t.Fatalf("got %d long headings, want 2: %+v", len(got), got)
+	}
+	if got[0].Line != 7 || got[0].Words != 11 {
+		t.Errorf("first = %+v, want line 7 with 11 words", got[0])
+	}
+	if got[1].Text != "A Setext Title That Also Runs Much Too Long For Scanning" {
+		t.Errorf("second = %+v", got[1])
+	}
+}
+
+func TestCheckLongHeadingsRespectsLimit(t *testing.T) {
+	src := []byte("# Short and scannable\n\nBody.\n")
+	if got := CheckLongHeadings(src, 6); len(got) != 0 {
+		t.Errorf("flagged a short heading: %+v", got)
+	}
+}
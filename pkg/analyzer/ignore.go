@@ -0,0 +1,111 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFile is the per-directory ignore file the walkers honor, the
+// .gitignore counterpart for analysis: glob patterns one per line,
+// # comments, and ! negation to re-include a path a broader pattern
+// excluded. Patterns match relative to the directory holding the file;
+// slash-free patterns also match by base name anywhere beneath it.
+const IgnoreFile = ".content-analyzerignore"
+
+// ignoreRule is one parsed pattern line.
+type ignoreRule struct {
+	pattern string
+	negate  bool
+}
+
+// ignoreScope is the parsed ignore file of one directory, applying to
+// everything beneath it.
+type ignoreScope struct {
+	dir   string
+	rules []ignoreRule
+}
+
+// loadIgnoreScope parses dir's ignore file; a missing file is an empty
+// scope, and unreadable ones are treated the same rather than failing
+// the walk.
+func loadIgnoreScope(dir string) ignoreScope {
+	scope := ignoreScope{dir: dir}
+	data, err := os.ReadFile(filepath.Join(dir, IgnoreFile))
+	if err != nil {
+		return scope
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule := ignoreRule{pattern: line}
+		if strings.HasPrefix(line, "!") {
+			rule.negate = true
+			rule.pattern = strings.TrimPrefix(line, "!")
+		}
+		// A trailing slash is the familiar way to write a directory
+		// pattern; matching drops it and the walk skips the subtree.
+		rule.pattern = strings.TrimSuffix(rule.pattern, "/")
+		scope.rules = append(scope.rules, rule)
+	}
+	return scope
+}
+
+// ignoreScopes stacks the ignore files above the current walk
+// position, outermost first.
+type ignoreScopes struct {
+	scopes []ignoreScope
+}
+
+// enter pushes dir's ignore file after dropping scopes the walk has
+// left, so the stack always mirrors the ancestry of dir.
+func (s *ignoreScopes) enter(dir string) {
+	s.prune(dir)
+	s.scopes = append(s.scopes, loadIgnoreScope(dir))
+}
+
+// prune drops scopes whose directory is not an ancestor of path.
+func (s *ignoreScopes) prune(path string) {
+	kept := s.scopes[:0]
+	for _, scope := range s.scopes {
+		if path == scope.dir || strings.HasPrefix(path, scope.dir+string(filepath.Separator)) {
+			kept = append(kept, scope)
+		}
+	}
+	s.scopes = kept
+}
+
+// ignored reports whether path is excluded by the stacked ignore
+// files. Rules apply outermost first and the last match wins, so a
+// nearer file (or a later line) overrides a broader one — the
+// gitignore precedence users expect, which is what makes ! negation
+// work.
+func (s *ignoreScopes) ignored(path string) bool {
+	decision := false
+	for _, scope := range s.scopes {
+		rel, err := filepath.Rel(scope.dir, path)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+		for _, rule := range scope.rules {
+			if matchIgnore(rule.pattern, rel) {
+				decision = !rule.negate
+			}
+		}
+	}
+	return decision
+}
+
+// matchIgnore matches one pattern against a scope-relative path.
+// Patterns with a slash match the relative path; slash-free ones match
+// the base name, so "draft.md" applies at any depth below the file.
+func matchIgnore(pattern, rel string) bool {
+	if strings.ContainsRune(pattern, '/') {
+		ok, err := filepath.Match(filepath.FromSlash(pattern), rel)
+		return err == nil && ok
+	}
+	ok, err := filepath.Match(pattern, filepath.Base(rel))
+	return err == nil && ok
+}
@@ -0,0 +1,51 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestAnalyzePathIgnoreFile(t *testing.T) {
+	root := t.TempDir()
+	write := func(rel, content string) {
+		path := filepath.Join(root, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	page := "# Title\n\nSome prose for the analyzer to read.\n"
+	write(IgnoreFile, "draft-*.md\ngenerated/\n")
+	write("keep.md", page)
+	write("draft-notes.md", page)
+	write("generated/api.md", page)
+	write("sub/"+IgnoreFile, "*.md\n!keep.md\n")
+	write("sub/keep.md", page)
+	write("sub/skip.md", page)
+
+	a := New(config.Default())
+	results, err := a.AnalyzePath(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]bool{}
+	for _, r := range results {
+		rel, _ := filepath.Rel(root, r.Path)
+		got[filepath.ToSlash(rel)] = true
+	}
+	for _, want := range []string{"keep.md", "sub/keep.md"} {
+		if !got[want] {
+			t.Errorf("%s missing from results %v", want, got)
+		}
+	}
+	for _, banned := range []string{"draft-notes.md", "generated/api.md", "sub/skip.md"} {
+		if got[banned] {
+			t.Errorf("%s analyzed despite ignore rules", banned)
+		}
+	}
+}
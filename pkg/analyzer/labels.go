@@ -0,0 +1,27 @@
+package analyzer
+
+import "github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+
+// defaultGradeBands map Flesch-Kincaid grades to the labels stakeholders
+// actually read; "College" lands better in a review than "14.2".
+var defaultGradeBands = []config.GradeBand{
+	{MaxGrade: 5, Label: "Elementary"},
+	{MaxGrade: 8, Label: "Middle school"},
+	{MaxGrade: 12, Label: "High school"},
+	{MaxGrade: 16, Label: "College"},
+}
+
+// GradeLabel returns the reading-level label for an FK grade under the
+// given bands, falling back to the built-in mapping when bands is
+// empty. Grades above every band are "Professional".
+func GradeLabel(grade float64, bands []config.GradeBand) string {
+	if len(bands) == 0 {
+		bands = defaultGradeBands
+	}
+	for _, b := range bands {
+		if grade <= b.MaxGrade {
+			return b.Label
+		}
+	}
+	return "Professional"
+}
@@ -0,0 +1,98 @@
+package analyzer
+
+import "strings"
+
+// Language codes the analyzer has formulas for. Anything else scores
+// with the English formulas.
+const (
+	LangEnglish = "en"
+	LangSpanish = "es"
+	LangGerman  = "de"
+)
+
+// vowelSets drives syllable estimation per language; see syllablesLang.
+var vowelSets = map[string]string{
+	LangEnglish: "aeiouy",
+	LangSpanish: "aeiouáéíóúü",
+	LangGerman:  "aeiouyäöü",
+}
+
+// docLanguage reads a document's frontmatter lang key, so a translated
+// page can carry its language with it. Empty when absent.
+func docLanguage(doc Document) string {
+	fm, ok := doc.(interface{ Meta() map[string]any })
+	if !ok {
+		return ""
+	}
+	if v, ok := fm.Meta()["lang"].(string); ok {
+		return normalizeLang(v)
+	}
+	return ""
+}
+
+// normalizeLang reduces a BCP 47 tag to the bare language code the
+// formula tables use: "es-MX" selects the Spanish formulas.
+func normalizeLang(lang string) string {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	if i := strings.IndexAny(lang, "-_"); i > 0 {
+		lang = lang[:i]
+	}
+	return lang
+}
+
+// applyLanguage replaces the English readability scores with
+// language-appropriate formulas. Spanish uses Fernández-Huerta for
+// ease and Crawford for grade; German uses Amstad's Flesch adaptation
+// for ease and the first Wiener Sachtextformel for grade. ARI stays,
+// being character-based; Fog, SMOG, and Coleman-Liau are zeroed since
+// their complex-word and letter constants are English calibrations,
+// and a zero score never trips a maximum threshold.
+func applyLanguage(m *Metrics, lang string) {
+	if lang == "" || lang == LangEnglish || m.Words == 0 {
+		return
+	}
+	wps := float64(m.Words) / float64(m.Sentences)
+	spw := float64(m.Syllables) / float64(m.Words)
+	sp100 := 100 * float64(m.Sentences) / float64(m.Words)
+
+	switch lang {
+	case LangSpanish:
+		m.FleschEase = 206.84 - 60*spw - 1.02*wps
+		m.FleschKincaid = -0.205*sp100 + 0.049*(100*spw) - 3.407
+	case LangGerman:
+		m.FleschEase = 180 - wps - 58.5*spw
+		ms := 100 * float64(m.ComplexWords) / float64(m.Words)
+		iw := 100 * float64(m.longWords) / float64(m.Words)
+		es := 100 * float64(m.monosyllables) / float64(m.Words)
+		m.FleschKincaid = 0.1935*ms + 0.1672*wps + 0.1297*iw - 0.0327*es - 0.875
+	default:
+		return
+	}
+	m.GunningFog = 0
+	m.SMOG = 0
+	m.ColemanLiau = 0
+}
+
+// syllablesLang estimates syllables with a language's vowel set. The
+// English estimator keeps its silent-e adjustment; Spanish and German
+// pronounce their final vowels.
+func syllablesLang(word, lang string) int {
+	vowels, ok := vowelSets[lang]
+	if !ok || lang == LangEnglish {
+		return syllables(word)
+	}
+	word = strings.ToLower(word)
+	count := 0
+	prevVowel := false
+	for _, r := range word {
+		vowel := strings.ContainsRune(vowels, r)
+		if vowel && !prevVowel {
+			count++
+		}
+		prevVowel = vowel
+	}
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
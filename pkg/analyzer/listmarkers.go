@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// bulletMarker matches an unordered list item and captures its marker;
+// orderedMarker does the same for ordered items' delimiter.
+var (
+	bulletMarker  = regexp.MustCompile(`^\s*([-*+])\s+\S`)
+	orderedMarker = regexp.MustCompile(`^\s*\d+([.)])\s+\S`)
+)
+
+// CheckListMarkers flags files that mix list marker styles: bullets
+// switching between -, *, and +, or ordered items switching between
+// 1. and 1) forms. The first marker of each kind sets the file's
+// style; every later deviation is reported with its line. A file
+// consistently using any single style passes.
+func CheckListMarkers(src []byte) []string {
+	var out []string
+	bulletCounts := map[string]int{}
+	orderedCounts := map[string]int{}
+	bulletStyle, orderedStyle := "", ""
+	inFence := false
+	for i, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if m := bulletMarker.FindStringSubmatch(line); m != nil {
+			bulletCounts[m[1]]++
+			if bulletStyle == "" {
+				bulletStyle = m[1]
+			} else if m[1] != bulletStyle {
+				out = append(out, fmt.Sprintf("bullet marker %q at line %d; file opened with %q", m[1], i+1, bulletStyle))
+			}
+			continue
+		}
+		if m := orderedMarker.FindStringSubmatch(line); m != nil {
+			delim := "1" + m[1]
+			orderedCounts[delim]++
+			if orderedStyle == "" {
+				orderedStyle = delim
+			} else if delim != orderedStyle {
+				out = append(out, fmt.Sprintf("ordered marker %q at line %d; file opened with %q", delim, i+1, orderedStyle))
+			}
+		}
+	}
+	if len(bulletCounts) > 1 {
+		out = append(out, "mixed bullet markers: "+markerTally(bulletCounts))
+	}
+	if len(orderedCounts) > 1 {
+		out = append(out, "mixed ordered markers: "+markerTally(orderedCounts))
+	}
+	return out
+}
+
+// markerTally renders marker counts in a stable order for summaries.
+func markerTally(counts map[string]int) string {
+	var parts []string
+	for _, m := range []string{"-", "*", "+", "1.", "1)"} {
+		if counts[m] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %q", counts[m], m))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
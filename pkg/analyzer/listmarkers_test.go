@@ -0,0 +1,42 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckListMarkers(t *testing.T) {
+	src := []byte(`# Title
+
+- first item
+- second item
+* deviant item
+
+1. step one
+1) deviant step
+
+` + "```" + `
+* not a list, code
+` + "```" + `
+`)
+	got := CheckListMarkers(src)
+	if len(got) != 4 {
+		t.Fatalf("CheckListMarkers = %v, want 4 issues", got)
+	}
+	if !strings.Contains(got[0], `"*" at line 5`) {
+		t.Errorf("first issue = %q, want the * bullet at line 5", got[0])
+	}
+	if !strings.Contains(got[1], `"1)" at line 8`) {
+		t.Errorf("second issue = %q, want the 1) marker at line 8", got[1])
+	}
+	if !strings.Contains(got[2], "mixed bullet markers") || !strings.Contains(got[3], "mixed ordered markers") {
+		t.Errorf("summaries = %q, %q", got[2], got[3])
+	}
+}
+
+func TestCheckListMarkersConsistent(t *testing.T) {
+	src := []byte("- one\n- two\n\n1. step\n2. step\n\n*emphasis, not a bullet*\n")
+	if got := CheckListMarkers(src); len(got) != 0 {
+		t.Errorf("CheckListMarkers = %v, want none", got)
+	}
+}
@@ -0,0 +1,67 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+)
+
+var listMarker = regexp.MustCompile(`^[-*+]\s+|^\d+[.)]\s+`)
+
+// MaxListDepth scans src for the deepest bullet or numbered list
+// nesting and the line where it occurs. Four levels of bullets is a
+// structure problem, not a prose problem, so this is tracked next to
+// the readability formulas rather than inside them. Indentation is
+// measured against a stack of seen indents, so two- and four-space
+// styles both count correctly.
+func MaxListDepth(src []byte) (depth, line int) {
+	inFence := false
+	var stack []int
+	for i, raw := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimLeft(raw, " \t")
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		if !listMarker.MatchString(trimmed) {
+			// Indented continuation lines keep the stack; anything at
+			// or left of the first item's indent ends the list.
+			indent := indentWidth(raw)
+			if len(stack) > 0 && indent <= stack[0] {
+				stack = stack[:0]
+			}
+			continue
+		}
+		indent := indentWidth(raw)
+		for len(stack) > 0 && indent <= stack[len(stack)-1] {
+			stack = stack[:len(stack)-1]
+		}
+		stack = append(stack, indent)
+		if len(stack) > depth {
+			depth = len(stack)
+			line = i + 1
+		}
+	}
+	return depth, line
+}
+
+// indentWidth measures leading whitespace with tabs as four columns.
+func indentWidth(line string) int {
+	w := 0
+	for _, r := range line {
+		switch r {
+		case ' ':
+			w++
+		case '\t':
+			w += 4
+		default:
+			return w
+		}
+	}
+	return w
+}
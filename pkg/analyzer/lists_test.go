@@ -0,0 +1,29 @@
+package analyzer
+
+import "testing"
+
+func TestMaxListDepth(t *testing.T) {
+	src := []byte(`# Doc
+
+- one
+  - two
+    - three
+      - four
+- back to one
+
+Plain paragraph resets everything.
+
+- one again
+`)
+	depth, line := MaxListDepth(src)
+	if depth != 4 || line != 6 {
+		t.Errorf("MaxListDepth = %d at line %d, want 4 at line 6", depth, line)
+	}
+}
+
+func TestMaxListDepthIgnoresFences(t *testing.T) {
+	src := []byte("```yaml\n- a\n  - b\n    - c\n      - d\n```\n")
+	if depth, _ := MaxListDepth(src); depth != 0 {
+		t.Errorf("depth = %d, want 0 inside a fence", depth)
+	}
+}
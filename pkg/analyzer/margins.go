@@ -0,0 +1,51 @@
+package analyzer
+
+import "github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+
+// Margin is one file's standing against one numeric threshold: the
+// measured value, the configured limit, and utilization as a percent
+// of budget. A passing file at 95% is the one the next edit tips over.
+type Margin struct {
+	Check   string  `json:"check"`
+	Value   float64 `json:"value"`
+	Limit   float64 `json:"limit"`
+	Percent float64 `json:"percent"`
+}
+
+// ThresholdMargins reports utilization against every configured numeric
+// threshold, for the proactive-maintenance view --report-threshold-margins
+// renders. Ceilings report value/limit; the min_ease floor inverts to
+// limit/value so 100% still means "at the line". Checks without a
+// configured limit are omitted.
+func ThresholdMargins(m Metrics, t config.Thresholds) []Margin {
+	var out []Margin
+	ceiling := func(check string, value float64, limit *float64) {
+		if limit == nil || *limit <= 0 {
+			return
+		}
+		out = append(out, Margin{Check: check, Value: value, Limit: *limit, Percent: value / *limit * 100})
+	}
+	ceiling("max_grade", m.FleschKincaid, t.MaxGrade)
+	ceiling("max_ari", m.ARI, t.MaxARI)
+	ceiling("max_fog", m.GunningFog, t.MaxFog)
+	ceiling("max_coleman_liau", m.ColemanLiau, t.MaxColemanLiau)
+	ceiling("max_smog", m.SMOG, t.MaxSMOG)
+	if t.MinEase != nil && *t.MinEase > 0 {
+		// limit/value explodes as ease approaches zero and goes negative
+		// past it; cap at 999 so the scale stays readable and the JSON
+		// stays finite. Anything over 100 is failing regardless.
+		pct := 999.0
+		if m.FleschEase > 0 {
+			if pct = *t.MinEase / m.FleschEase * 100; pct > 999 {
+				pct = 999
+			}
+		}
+		out = append(out, Margin{Check: "min_ease", Value: m.FleschEase, Limit: *t.MinEase, Percent: pct})
+	}
+	if t.MaxLines != nil && *t.MaxLines > 0 {
+		out = append(out, Margin{Check: "max_lines", Value: float64(m.Lines), Limit: float64(*t.MaxLines),
+			Percent: float64(m.Lines) / float64(*t.MaxLines) * 100})
+	}
+	ceiling("max_code_ratio", m.CodeRatio, t.MaxCodeRatio)
+	return out
+}
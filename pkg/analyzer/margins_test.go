@@ -0,0 +1,36 @@
+package analyzer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestThresholdMargins(t *testing.T) {
+	grade, lines := 11.2, 300
+	th := config.Thresholds{}
+	th.MaxGrade = &grade
+	minEase := 25.0
+	th.MinEase = &minEase
+	th.MaxLines = &lines
+
+	m := Metrics{FleschKincaid: 8.4, FleschEase: 50, Lines: 150}
+	got := ThresholdMargins(m, th)
+	if len(got) != 3 {
+		t.Fatalf("ThresholdMargins = %+v, want 3 entries", got)
+	}
+	byCheck := map[string]Margin{}
+	for _, mg := range got {
+		byCheck[mg.Check] = mg
+	}
+	if pct := byCheck["max_grade"].Percent; math.Abs(pct-75) > 1e-9 {
+		t.Errorf("max_grade percent = %.2f, want 75", pct)
+	}
+	if pct := byCheck["min_ease"].Percent; math.Abs(pct-50) > 1e-9 {
+		t.Errorf("min_ease percent = %.2f, want 50", pct)
+	}
+	if pct := byCheck["max_lines"].Percent; math.Abs(pct-50) > 1e-9 {
+		t.Errorf("max_lines percent = %.2f, want 50", pct)
+	}
+}
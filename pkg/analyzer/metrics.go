@@ -0,0 +1,285 @@
+package analyzer
+
+import (
+	"math"
+	"strings"
+	"unicode"
+)
+
+// Metrics holds the raw counts and readability scores for one document.
+type Metrics struct {
+	Words        int `json:"words"`
+	Sentences    int `json:"sentences"`
+	Syllables    int `json:"syllables"`
+	Characters   int `json:"characters"`
+	ComplexWords int `json:"complex_words"`
+	Lines        int `json:"lines"`
+
+	// CodeLines counts lines inside code blocks; CodeRatio is their
+	// share of the file's lines. High ratios mark pages that are code
+	// dumps with little explanation, which readability scores alone
+	// cannot catch.
+	CodeLines int     `json:"code_lines"`
+	CodeRatio float64 `json:"code_ratio"`
+
+	// ReadingTimeMinutes estimates reading time with prose, code, and
+	// headings each priced at their own speed; see ReadingTimeMinutes
+	// and config.Analysis.ReadingRates.
+	ReadingTimeMinutes float64 `json:"reading_time_minutes"`
+
+	// ScrollWeight estimates rendered page length in line-equivalents,
+	// pricing images and code-block chrome on top of raw lines; see
+	// ScrollWeight and MaxScrollWeight.
+	ScrollWeight int `json:"scroll_weight"`
+
+	// HeadingsByLevel counts headings by depth, index 0 holding H1s.
+	HeadingsByLevel [6]int `json:"headings_by_level"`
+
+	// MaxListDepth is the deepest list nesting in the file and
+	// MaxListDepthLine where it occurs; see MaxListDepth.
+	MaxListDepth     int `json:"max_list_depth,omitempty"`
+	MaxListDepthLine int `json:"max_list_depth_line,omitempty"`
+
+	FleschKincaid float64 `json:"flesch_kincaid_grade"`
+
+	// GradeLabel is the human reading-level band for FleschKincaid;
+	// see GradeLabel and config.Analysis.GradeLabels.
+	GradeLabel string `json:"grade_label,omitempty"`
+
+	ARI         float64 `json:"ari"`
+	GunningFog  float64 `json:"gunning_fog"`
+	FleschEase  float64 `json:"flesch_ease"`
+	ColemanLiau float64 `json:"coleman_liau"`
+	SMOG        float64 `json:"smog"`
+
+	// MetricSpread is max minus min across the grade-level formulas
+	// (FK, ARI, fog, Coleman-Liau, SMOG). The formulas weigh sentence
+	// length and word complexity differently, so a wide spread marks
+	// content one of them is misreading — or being gamed on — and the
+	// scores deserve a human look. Zero for languages scored by a
+	// single formula.
+	MetricSpread float64 `json:"metric_spread"`
+
+	// longWords and monosyllables feed the German Wiener
+	// Sachtextformel; see applyLanguage.
+	longWords     int
+	monosyllables int
+}
+
+// Score computes readability metrics for prose. Lines is left at zero;
+// it counts raw file lines, which the caller owns.
+func Score(prose string) Metrics {
+	return ScoreWith(prose, nil)
+}
+
+// ScoreWith is Score with per-word syllable overrides applied before
+// the formulas run. The heuristic estimator mis-counts many technical
+// terms, and FK and SMOG inherit the error; overrides keyed on the
+// lowercase word correct it.
+func ScoreWith(prose string, syllableOverrides map[string]int) Metrics {
+	return ScoreText(prose, ScoreOptions{SyllableOverrides: syllableOverrides})
+}
+
+// ScoreLanguage is ScoreWith scoring under a language's syllable rules
+// and readability formulas; see applyLanguage for which formulas each
+// language gets. Unknown languages score as English.
+func ScoreLanguage(prose, lang string, syllableOverrides map[string]int) Metrics {
+	return ScoreText(prose, ScoreOptions{Language: lang, SyllableOverrides: syllableOverrides})
+}
+
+// ScoreOptions bundles the scoring knobs the variants above set one at
+// a time. Zero values mean English formulas, no syllable overrides,
+// and the built-in abbreviation list.
+type ScoreOptions struct {
+	Language          string
+	SyllableOverrides map[string]int
+
+	// Abbreviations is the sentence-splitting abbreviation set; see
+	// SplitSentencesWith. Nil means the built-in defaults. Ignored
+	// when Splitter is set.
+	Abbreviations map[string]bool
+
+	// Splitter overrides the sentence splitter. Nil means a
+	// RuleSplitter over Abbreviations; see SentenceSplitter.
+	Splitter SentenceSplitter
+
+	// NoNormalize skips the typographic normalization pass; see
+	// NormalizeTypography. Off by default: identical sentences should
+	// score identically whichever editor authored them.
+	NoNormalize bool
+
+	// KeepEmoji counts emoji and :shortcodes: as content instead of
+	// stripping them before scoring; see StripEmoji.
+	KeepEmoji bool
+}
+
+// splitter resolves the effective sentence splitter for these options.
+func (o ScoreOptions) splitter() SentenceSplitter {
+	if o.Splitter != nil {
+		return o.Splitter
+	}
+	return RuleSplitter{Abbreviations: o.Abbreviations}
+}
+
+// typographyReplacer folds typographic punctuation to the ASCII
+// equivalents the formulas assume; see NormalizeTypography.
+var typographyReplacer = strings.NewReplacer(
+	"“", `"`, "”", `"`, // curly double quotes
+	"‘", "'", "’", "'", // curly single quotes
+	"–", "-", "—", "-", // en and em dashes
+	"…", "...", // ellipsis
+	"\u00a0", " ", "\u202f", " ", "\u2009", " ", // no-break and thin spaces
+)
+
+// NormalizeTypography folds smart quotes, en/em dashes, ellipses, and
+// non-breaking or thin spaces to their ASCII equivalents. Sentence
+// splitting only recognizes the ASCII forms — a closing curly quote
+// after a period suppresses the break, and an ellipsis character ends
+// no sentence — so without this pass the same prose scores differently
+// depending on which editor authored it.
+func NormalizeTypography(prose string) string {
+	return typographyReplacer.Replace(prose)
+}
+
+// ScoreText scores prose under the given options.
+func ScoreText(prose string, o ScoreOptions) Metrics {
+	if !o.NoNormalize {
+		prose = NormalizeTypography(prose)
+	}
+	if !o.KeepEmoji {
+		prose = StripEmoji(prose)
+	}
+	lang := o.Language
+	syllableOverrides := o.SyllableOverrides
+	var m Metrics
+	words := strings.Fields(prose)
+	m.Words = len(words)
+	if m.Words == 0 {
+		return m
+	}
+
+	for _, w := range words {
+		syl := syllablesWithLang(w, lang, syllableOverrides)
+		m.Syllables += syl
+		if syl >= 3 {
+			m.ComplexWords++
+		}
+		if syl == 1 {
+			m.monosyllables++
+		}
+		letters := 0
+		for _, r := range w {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				m.Characters++
+				letters++
+			}
+		}
+		if letters > 6 {
+			m.longWords++
+		}
+	}
+
+	m.Sentences = len(o.splitter().Split(prose))
+	if m.Sentences == 0 {
+		m.Sentences = 1
+	}
+
+	wps := float64(m.Words) / float64(m.Sentences)
+	spw := float64(m.Syllables) / float64(m.Words)
+	cpw := float64(m.Characters) / float64(m.Words)
+
+	m.FleschKincaid = 0.39*wps + 11.8*spw - 15.59
+	m.ARI = 4.71*cpw + 0.5*wps - 21.43
+	m.GunningFog = 0.4 * (wps + 100*float64(m.ComplexWords)/float64(m.Words))
+	m.FleschEase = 206.835 - 1.015*wps - 84.6*spw
+	m.ColemanLiau = 0.0588*(cpw*100) - 0.296*(100/wps) - 15.8
+	m.SMOG = 1.0430*math.Sqrt(float64(m.ComplexWords)*30/float64(m.Sentences)) + 3.1291
+	applyLanguage(&m, lang)
+	// applyLanguage zeroes the formulas a language lacks; a spread over
+	// a single surviving formula would be meaningless.
+	if m.GunningFog != 0 || m.ColemanLiau != 0 || m.SMOG != 0 {
+		grades := []float64{m.FleschKincaid, m.ARI, m.GunningFog, m.ColemanLiau, m.SMOG}
+		lo, hi := grades[0], grades[0]
+		for _, g := range grades[1:] {
+			lo = math.Min(lo, g)
+			hi = math.Max(hi, g)
+		}
+		m.MetricSpread = hi - lo
+	}
+	return m
+}
+
+// SplitSentences splits prose into sentences with the default
+// RuleSplitter; see SentenceSplitter for the splitting contract.
+func SplitSentences(prose string) []string {
+	return SplitSentencesWith(prose, nil)
+}
+
+// SplitSentencesWith is SplitSentences with an explicit abbreviation
+// set: a period ending a listed abbreviation ("e.g.", "approx.") does
+// not end the sentence. Nil means the built-in defaults; see
+// AbbreviationSet for loading domain lists.
+func SplitSentencesWith(prose string, abbrevs map[string]bool) []string {
+	return RuleSplitter{Abbreviations: abbrevs}.Split(prose)
+}
+
+// Syllables estimates the syllable count of a single word by counting
+// vowel groups, with a silent-e adjustment. Words always count at least
+// one syllable.
+func Syllables(word string) int {
+	return syllablesWith(word, nil)
+}
+
+// syllablesWith consults the override map, keyed on the lowercase word
+// with surrounding punctuation stripped, before estimating.
+func syllablesWith(word string, overrides map[string]int) int {
+	return syllablesWithLang(word, LangEnglish, overrides)
+}
+
+// syllablesWithLang is syllablesWith estimating under a language's
+// vowel rules; overrides still win.
+func syllablesWithLang(word, lang string, overrides map[string]int) int {
+	if len(overrides) > 0 {
+		key := strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}))
+		if n, ok := overrides[key]; ok && n > 0 {
+			return n
+		}
+	}
+	return syllablesLang(word, lang)
+}
+
+func syllables(word string) int {
+	word = strings.ToLower(word)
+	count := 0
+	prevVowel := false
+	lastVowelAt := -1
+	letters := 0
+	for i, r := range word {
+		if !unicode.IsLetter(r) {
+			prevVowel = false
+			continue
+		}
+		letters++
+		vowel := strings.ContainsRune("aeiouy", r)
+		if vowel && !prevVowel {
+			count++
+			lastVowelAt = i
+		}
+		prevVowel = vowel
+	}
+	if letters == 0 {
+		return 1
+	}
+	// Silent trailing e: "release" ends in a vowel group that is not
+	// pronounced, unless it is the only one ("the").
+	if strings.HasSuffix(word, "e") && !strings.HasSuffix(word, "le") &&
+		count > 1 && lastVowelAt == len(word)-1 {
+		count--
+	}
+	if count < 1 {
+		count = 1
+	}
+	return count
+}
@@ -0,0 +1,150 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestSplitSentences(t *testing.T) {
+	got := SplitSentences("This is one. This is two! Is this three? Version 1.2 stays whole.")
+	want := []string{
+		"This is one.",
+		"This is two!",
+		"Is this three?",
+		"Version 1.2 stays whole.",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d sentences, want %d: %q", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("sentence %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSyllables(t *testing.T) {
+	cases := map[string]int{
+		"the":      1,
+		"simple":   2,
+		"release":  2,
+		"security": 4,
+		"a":        1,
+	}
+	for word, want := range cases {
+		if got := Syllables(word); got != want {
+			t.Errorf("Syllables(%q) = %d, want %d", word, got, want)
+		}
+	}
+}
+
+func TestScore(t *testing.T) {
+	m := Score("The cat sat on the mat. The dog ran to the park.")
+	if m.Words != 12 {
+		t.Errorf("Words = %d, want 12", m.Words)
+	}
+	if m.Sentences != 2 {
+		t.Errorf("Sentences = %d, want 2", m.Sentences)
+	}
+	if m.FleschKincaid > 3 {
+		t.Errorf("FleschKincaid = %.1f, want early-grade score", m.FleschKincaid)
+	}
+	if m.FleschEase < 90 {
+		t.Errorf("FleschEase = %.1f, want very easy score", m.FleschEase)
+	}
+}
+
+func TestScoreEmpty(t *testing.T) {
+	m := Score("")
+	if m.Words != 0 || m.Sentences != 0 {
+		t.Errorf("Score(\"\") = %+v, want zero counts", m)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{4, 1, 3, 2}
+	if got := Percentile(values, 50); got != 2.5 {
+		t.Errorf("Percentile(50) = %v, want 2.5", got)
+	}
+	if got := Percentile(values, 100); got != 4 {
+		t.Errorf("Percentile(100) = %v, want 4", got)
+	}
+	if got := Percentile(values, 0); got != 1 {
+		t.Errorf("Percentile(0) = %v, want 1", got)
+	}
+	if got := Percentile(nil, 90); got != 0 {
+		t.Errorf("Percentile(nil) = %v, want 0", got)
+	}
+}
+
+func TestSyllableOverrides(t *testing.T) {
+	overrides := map[string]int{"idempotent": 5}
+	base := ScoreWith("Idempotent, by design.", nil)
+	fixed := ScoreWith("Idempotent, by design.", overrides)
+	if fixed.Syllables != base.Syllables+1 {
+		t.Errorf("Syllables = %d, want %d", fixed.Syllables, base.Syllables+1)
+	}
+}
+
+func TestGradeLabel(t *testing.T) {
+	if got := GradeLabel(7.2, nil); got != "Middle school" {
+		t.Errorf("GradeLabel(7.2) = %q, want Middle school", got)
+	}
+	if got := GradeLabel(19, nil); got != "Professional" {
+		t.Errorf("GradeLabel(19) = %q, want Professional", got)
+	}
+	bands := []config.GradeBand{{MaxGrade: 10, Label: "Easy"}}
+	if got := GradeLabel(3, bands); got != "Easy" {
+		t.Errorf("GradeLabel(3, custom) = %q, want Easy", got)
+	}
+}
+
+func TestNormalizeTypographyConvergence(t *testing.T) {
+	plain := `The "deploy" step - as documented - takes three minutes.`
+	fancy := "The “deploy” step — as documented — takes three minutes."
+	a, b := Score(plain), Score(fancy)
+	if a.Words != b.Words || a.Characters != b.Characters {
+		t.Errorf("counts diverge: plain %d words %d chars, fancy %d words %d chars",
+			a.Words, a.Characters, b.Words, b.Characters)
+	}
+	if a.ARI != b.ARI || a.ColemanLiau != b.ColemanLiau {
+		t.Errorf("scores diverge: ARI %.2f vs %.2f, CL %.2f vs %.2f",
+			a.ARI, b.ARI, a.ColemanLiau, b.ColemanLiau)
+	}
+
+	quoted := "He said \u201cstop.\u201d Then he left."
+	norm := Score(quoted)
+	raw := ScoreText(quoted, ScoreOptions{NoNormalize: true})
+	if norm.Sentences != 2 {
+		t.Errorf("normalized Sentences = %d, want 2", norm.Sentences)
+	}
+	if raw.Sentences != 1 {
+		t.Errorf("NoNormalize Sentences = %d, want 1: the curly quote hides the boundary", raw.Sentences)
+	}
+}
+
+func TestMetricSpread(t *testing.T) {
+	m := Score("The cat sat on the mat. The dog ran to the park.")
+	grades := []float64{m.FleschKincaid, m.ARI, m.GunningFog, m.ColemanLiau, m.SMOG}
+	lo, hi := grades[0], grades[0]
+	for _, g := range grades[1:] {
+		if g < lo {
+			lo = g
+		}
+		if g > hi {
+			hi = g
+		}
+	}
+	if m.MetricSpread != hi-lo {
+		t.Errorf("MetricSpread = %.2f, want %.2f", m.MetricSpread, hi-lo)
+	}
+	if m.MetricSpread <= 0 {
+		t.Errorf("MetricSpread = %.2f, want positive", m.MetricSpread)
+	}
+
+	de := ScoreLanguage("Der Hund lief schnell durch den Park zur Stadt.", "de", nil)
+	if de.MetricSpread != 0 {
+		t.Errorf("German MetricSpread = %.2f, want 0 with a single formula", de.MetricSpread)
+	}
+}
@@ -0,0 +1,132 @@
+package analyzer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// sourceWord is one whitespace-delimited token of the original source
+// with its byte span.
+type sourceWord struct {
+	text  string
+	start int
+	end   int
+	line  int
+}
+
+// Locator maps normalized prose fragments back to byte offsets in the
+// original source. Prose extraction collapses whitespace and strips
+// inline markup, so matching happens word by word: a fragment locates
+// where its word sequence appears in the source. A forward-moving
+// cursor keeps repeated sentences resolving in document order.
+type Locator struct {
+	words []sourceWord
+	pos   int
+}
+
+// NewLocator tokenizes src into words with byte offsets and line
+// numbers.
+func NewLocator(src []byte) *Locator {
+	l := &Locator{}
+	line := 1
+	start, startLine := -1, 1
+	for i, b := range src {
+		if unicode.IsSpace(rune(b)) {
+			if start >= 0 {
+				l.words = append(l.words, sourceWord{
+					text:  string(src[start:i]),
+					start: start,
+					end:   i,
+					line:  startLine,
+				})
+				start = -1
+			}
+			if b == '\n' {
+				line++
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+			startLine = line
+		}
+	}
+	if start >= 0 {
+		l.words = append(l.words, sourceWord{
+			text:  string(src[start:]),
+			start: start,
+			end:   len(src),
+			line:  startLine,
+		})
+	}
+	return l
+}
+
+// Locate finds text's word sequence in the source, returning its byte
+// span and starting line. Inline markup means source words carry
+// decoration prose words lack ("`flag`" vs "flag"), so words compare
+// on their letter-and-digit cores. The second return is false when
+// the fragment cannot be located, e.g. text synthesized by a check.
+func (l *Locator) Locate(text string) (start, end, line int, ok bool) {
+	target := strings.Fields(text)
+	if len(target) == 0 {
+		return 0, 0, 0, false
+	}
+	if i := l.match(target, l.pos); i >= 0 {
+		return l.span(i, len(target))
+	}
+	// Out-of-order fragment (e.g. a re-scored sentence): retry from
+	// the top before giving up.
+	if i := l.match(target, 0); i >= 0 {
+		return l.span(i, len(target))
+	}
+	return 0, 0, 0, false
+}
+
+// span returns the byte range covering n words starting at word i and
+// advances the cursor past them.
+func (l *Locator) span(i, n int) (start, end, line int, ok bool) {
+	last := i + n - 1
+	l.pos = last + 1
+	return l.words[i].start, l.words[last].end, l.words[i].line, true
+}
+
+// match searches for the word sequence starting at word index from.
+func (l *Locator) match(target []string, from int) int {
+	for i := from; i+len(target) <= len(l.words); i++ {
+		found := true
+		for j, t := range target {
+			if !wordEqual(l.words[i+j].text, t) {
+				found = false
+				break
+			}
+		}
+		if found {
+			return i
+		}
+	}
+	return -1
+}
+
+// wordEqual compares a source word with a prose word on their
+// letter-and-digit cores, ignoring the punctuation and inline markup
+// prose extraction strips. The source core only needs the prose core
+// as a prefix: a markdown link is one source word whose core starts
+// with the link text but drags the URL behind it.
+func wordEqual(source, prose string) bool {
+	p := wordCore(prose)
+	if p == "" {
+		return true
+	}
+	return strings.HasPrefix(wordCore(source), p)
+}
+
+func wordCore(w string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(w) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,71 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestLocatorPlainSentence(t *testing.T) {
+	src := []byte("# Title\n\nFirst sentence here. Second sentence follows.\n")
+	loc := NewLocator(src)
+	start, end, line, ok := loc.Locate("Second sentence follows.")
+	if !ok {
+		t.Fatal("sentence not located")
+	}
+	if got := string(src[start:end]); got != "Second sentence follows." {
+		t.Errorf("span = %q", got)
+	}
+	if line != 3 {
+		t.Errorf("line = %d, want 3", line)
+	}
+}
+
+func TestLocatorSurvivesInlineMarkup(t *testing.T) {
+	src := []byte("Run the `deploy` command with care.\n")
+	loc := NewLocator(src)
+	start, end, _, ok := loc.Locate("Run the deploy command with care.")
+	if !ok {
+		t.Fatal("sentence not located across inline code")
+	}
+	if start != 0 || end != len(src)-1 {
+		t.Errorf("span = [%d,%d), want the whole line", start, end)
+	}
+}
+
+func TestLocatorUnknownFragment(t *testing.T) {
+	loc := NewLocator([]byte("Some prose.\n"))
+	if _, _, _, ok := loc.Locate("entirely absent words"); ok {
+		t.Error("located a fragment that is not in the source")
+	}
+}
+
+func TestSentenceOffsetsInAnalyze(t *testing.T) {
+	a := New(config.Default())
+	a.IncludeSentences = true
+	src := []byte("# Page\n\nShort first sentence. A second sentence with a [link](https://example.com) inside.\n")
+	res, err := a.Analyze("docs/page.md", src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Sentences) < 2 {
+		t.Fatalf("got %d sentences, want at least 2", len(res.Sentences))
+	}
+	// The unpunctuated heading merges into the first sentence, so its
+	// span starts at the heading text on line 1.
+	first := res.Sentences[0]
+	if first.Start == 0 && first.End == 0 {
+		t.Fatalf("first sentence has no span: %+v", first)
+	}
+	if got := string(src[first.Start:first.End]); !strings.HasSuffix(got, "Short first sentence.") {
+		t.Errorf("first span = %q", got)
+	}
+	second := res.Sentences[1]
+	if got := string(src[second.Start:second.End]); !strings.HasPrefix(got, "A second sentence") {
+		t.Errorf("second span = %q", got)
+	}
+	if second.Line != 3 {
+		t.Errorf("second line = %d, want 3", second.Line)
+	}
+}
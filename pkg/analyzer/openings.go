@@ -0,0 +1,93 @@
+package analyzer
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// RepeatedOpening is a run of consecutive sentences starting with the
+// same word. Monotonous openings ("The... The... The...") hurt
+// readability in a way the grade formulas cannot see.
+type RepeatedOpening struct {
+	// Word is the shared opening word, lowercased.
+	Word string `json:"word"`
+
+	// Count is the length of the run.
+	Count int `json:"count"`
+
+	// Line is where the run starts, zero when the sentence could not
+	// be located in the source.
+	Line int `json:"line,omitempty"`
+}
+
+// CheckRepeatedOpenings finds runs of at least minRun consecutive
+// sentences opening with the same word. Lines are located by searching
+// src for the first sentence of each run, best effort since prose is
+// extracted from markup.
+func CheckRepeatedOpenings(src []byte, sentences []string, minRun int) []RepeatedOpening {
+	if minRun < 2 {
+		minRun = 2
+	}
+	var out []RepeatedOpening
+	run := 0
+	word := ""
+	start := ""
+	flush := func() {
+		if run >= minRun {
+			out = append(out, RepeatedOpening{
+				Word:  word,
+				Count: run,
+				Line:  lineOf(src, start),
+			})
+		}
+		run = 0
+	}
+	for _, s := range sentences {
+		w := openingWord(s)
+		if w == "" {
+			flush()
+			word = ""
+			continue
+		}
+		if w == word {
+			run++
+			continue
+		}
+		flush()
+		word, run, start = w, 1, s
+	}
+	flush()
+	return out
+}
+
+func (o RepeatedOpening) String() string {
+	return fmt.Sprintf("%d consecutive sentences start with %q", o.Count, o.Word)
+}
+
+// openingWord returns a sentence's first word, lowercased with
+// punctuation stripped.
+func openingWord(s string) string {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToLower(strings.TrimFunc(fields[0], func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}))
+}
+
+// lineOf locates the 1-based line where sentence begins in src, using
+// a leading fragment long enough to be distinctive.
+func lineOf(src []byte, sentence string) int {
+	frag := sentence
+	if len(frag) > 40 {
+		frag = frag[:40]
+	}
+	idx := bytes.Index(src, []byte(frag))
+	if idx < 0 {
+		return 0
+	}
+	return bytes.Count(src[:idx], []byte("\n")) + 1
+}
@@ -0,0 +1,26 @@
+package analyzer
+
+import "testing"
+
+func TestCheckRepeatedOpenings(t *testing.T) {
+	src := []byte(`The pipeline builds the image.
+The scanner signs it.
+The registry stores it.
+Finally, the gate verifies the signature.
+`)
+	sentences := SplitSentences(string(src))
+	got := CheckRepeatedOpenings(src, sentences, 3)
+	if len(got) != 1 {
+		t.Fatalf("got %v, want one run", got)
+	}
+	if got[0].Word != "the" || got[0].Count != 3 || got[0].Line != 1 {
+		t.Errorf("got %+v, want the x3 at line 1", got[0])
+	}
+}
+
+func TestCheckRepeatedOpeningsBelowThreshold(t *testing.T) {
+	sentences := []string{"The build runs.", "The scan follows.", "Then we deploy."}
+	if got := CheckRepeatedOpenings(nil, sentences, 3); len(got) != 0 {
+		t.Errorf("got %v, want none for a run of two", got)
+	}
+}
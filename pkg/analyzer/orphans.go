@@ -0,0 +1,118 @@
+package analyzer
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// inlineLink matches markdown inline links and images; the target is
+// capture group 1.
+var inlineLink = regexp.MustCompile(`!?\[[^\]]*\]\(([^)\s]+)[^)]*\)`)
+
+// entrypoints are basenames that count as reachable without inbound
+// links: site and section entry pages that navigation, not prose,
+// links to.
+var entrypoints = map[string]bool{
+	"index.md":  true,
+	"readme.md": true,
+}
+
+// FindOrphans walks root, builds the internal link graph across its
+// markdown files, and returns the pages nothing links to, sorted.
+// Entry pages (index.md, README.md) are exempt: navigation reaches
+// them without prose links. Orphans are usually stale pages that fell
+// out of the site structure, which no per-file metric can see.
+func (a *Analyzer) FindOrphans(root string) ([]string, error) {
+	info, err := os.Stat(root)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("orphan detection needs a directory, got %s", root)
+	}
+
+	var pages []string
+	linked := map[string]bool{}
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(strings.ToLower(path), ".md") {
+			return err
+		}
+		pages = append(pages, path)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			if a.Strict {
+				return err
+			}
+			a.Log.Info("file errored", "path", path, "error", err)
+			return nil
+		}
+		for _, target := range extractLinks(src) {
+			if resolved := resolveLink(root, path, target); resolved != "" {
+				linked[resolved] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var orphans []string
+	for _, p := range pages {
+		if entrypoints[strings.ToLower(filepath.Base(p))] {
+			continue
+		}
+		if !linked[p] {
+			orphans = append(orphans, p)
+		}
+	}
+	sort.Strings(orphans)
+	return orphans, nil
+}
+
+// extractLinks returns the targets of inline links in raw markdown,
+// code fences excluded.
+func extractLinks(src []byte) []string {
+	var out []string
+	inFence := false
+	for _, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, m := range inlineLink.FindAllStringSubmatch(line, -1) {
+			out = append(out, m[1])
+		}
+	}
+	return out
+}
+
+// resolveLink resolves an internal markdown link target to the path of
+// the file it points at, or "" for external links, anchors, and
+// non-markdown targets. Targets resolve relative to the linking file;
+// a leading slash rebases on the analyzed root, matching how static
+// site generators treat absolute paths.
+func resolveLink(root, from, target string) string {
+	if strings.Contains(target, "://") || strings.HasPrefix(target, "mailto:") {
+		return ""
+	}
+	if i := strings.IndexAny(target, "#?"); i >= 0 {
+		target = target[:i]
+	}
+	if target == "" || !strings.HasSuffix(strings.ToLower(target), ".md") {
+		return ""
+	}
+	if strings.HasPrefix(target, "/") {
+		return filepath.Join(root, filepath.FromSlash(target))
+	}
+	return filepath.Join(filepath.Dir(from), filepath.FromSlash(target))
+}
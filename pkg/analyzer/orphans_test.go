@@ -0,0 +1,51 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindOrphans(t *testing.T) {
+	dir := t.TempDir()
+	write := func(rel, content string) {
+		t.Helper()
+		path := filepath.Join(dir, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("index.md", "# Home\n\nStart with the [guide](guide.md).\n")
+	write("guide.md", "# Guide\n\nSee also [the nested page](nested/page.md).\n")
+	write("nested/page.md", "# Nested\n\nLinks back [home](/index.md).\n")
+	write("stale.md", "# Stale\n\nNothing links here.\n")
+	write("nested/index.md", "# Section\n\nEntry pages are exempt.\n")
+
+	a := New(nil)
+	orphans, err := a.FindOrphans(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orphans) != 1 || filepath.Base(orphans[0]) != "stale.md" {
+		t.Errorf("orphans = %v, want only stale.md", orphans)
+	}
+}
+
+func TestResolveLinkExternalAndAnchors(t *testing.T) {
+	for _, target := range []string{
+		"https://example.com/page.md",
+		"mailto:docs@example.com",
+		"#section",
+		"../assets/logo.png",
+	} {
+		if got := resolveLink("docs", "docs/a.md", target); got != "" {
+			t.Errorf("resolveLink(%q) = %q, want external/non-page targets dropped", target, got)
+		}
+	}
+	if got := resolveLink("docs", filepath.Join("docs", "sub", "a.md"), "../b.md"); got != filepath.Join("docs", "b.md") {
+		t.Errorf("relative link resolved to %q", got)
+	}
+}
@@ -0,0 +1,25 @@
+package analyzer
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+// RelativizePaths rewrites each result's Path relative to root, in
+// forward-slash form. Absolute paths from machine-specific invocations
+// otherwise clutter reports and make them differ between CI and local
+// runs. Paths outside root are left as given, only cleaned, since a
+// ".."-riddled relative path is worse than an honest absolute one.
+func RelativizePaths(results []*Result, root string) {
+	for _, r := range results {
+		r.Path = relativize(r.Path, root)
+	}
+}
+
+func relativize(path, root string) string {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filepath.ToSlash(filepath.Clean(path))
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,19 @@
+package analyzer
+
+import "testing"
+
+func TestRelativize(t *testing.T) {
+	cases := []struct {
+		path, root, want string
+	}{
+		{"/home/ci/repo/docs/index.md", "/home/ci/repo", "docs/index.md"},
+		{"docs/index.md", "docs", "index.md"},
+		{"docs/index.md", "docs/", "index.md"},
+		{"/etc/motd.md", "/home/ci/repo", "/etc/motd.md"},
+	}
+	for _, c := range cases {
+		if got := relativize(c.path, c.root); got != c.want {
+			t.Errorf("relativize(%q, %q) = %q, want %q", c.path, c.root, got, c.want)
+		}
+	}
+}
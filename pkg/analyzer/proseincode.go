@@ -0,0 +1,76 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SuspectCodeBlock is a fenced code block whose content reads like
+// prose, usually the fallout of a missing closing fence: the swallowed
+// text renders as a giant code box and escapes readability analysis.
+type SuspectCodeBlock struct {
+	Line       int `json:"line"`
+	ProseLines int `json:"prose_lines"`
+	TotalLines int `json:"total_lines"`
+}
+
+// String renders the block for issue listings.
+func (s SuspectCodeBlock) String() string {
+	return fmt.Sprintf("code block looks like prose (%d of %d lines); check for a missing closing fence",
+		s.ProseLines, s.TotalLines)
+}
+
+// CheckProseInCode scans raw markdown for fenced blocks where most
+// lines read as natural language rather than code. The heuristic calls
+// a line prose when it has several words including common English
+// glue and none of the characters code leans on; a block is flagged
+// when at least three content lines are mostly prose.
+func CheckProseInCode(src []byte) []SuspectCodeBlock {
+	var out []SuspectCodeBlock
+	lines := strings.Split(string(src), "\n")
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if !strings.HasPrefix(trimmed, "```") && !strings.HasPrefix(trimmed, "~~~") {
+			continue
+		}
+		marker := trimmed[:3]
+		start := i + 1
+		prose, total := 0, 0
+		for i++; i < len(lines); i++ {
+			row := strings.TrimSpace(lines[i])
+			if strings.HasPrefix(row, marker) {
+				break
+			}
+			if row == "" {
+				continue
+			}
+			total++
+			if proseLine(row) {
+				prose++
+			}
+		}
+		if total >= 3 && float64(prose)/float64(total) >= 0.6 {
+			out = append(out, SuspectCodeBlock{Line: start, ProseLines: prose, TotalLines: total})
+		}
+	}
+	return out
+}
+
+// proseLine reports whether a code-block line reads as natural
+// language: several words, at least one common English glue word, and
+// none of the punctuation code leans on.
+func proseLine(line string) bool {
+	if strings.ContainsAny(line, "={}<>;$|`") {
+		return false
+	}
+	words := strings.Fields(line)
+	if len(words) < 5 {
+		return false
+	}
+	for _, w := range words {
+		if defaultStopwordSet[strings.ToLower(strings.Trim(w, ".,!?"))] {
+			return true
+		}
+	}
+	return false
+}
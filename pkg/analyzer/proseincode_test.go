@@ -0,0 +1,35 @@
+package analyzer
+
+import "testing"
+
+func TestCheckProseInCodeFlagsSwallowedProse(t *testing.T) {
+	src := []byte("# Doc\n\n```bash\necho hi\n```\n\n```\n" +
+		"This paragraph was supposed to follow the example above.\n" +
+		"It explains what the command does and why you would run it.\n" +
+		"Nothing here is code, but the missing fence hides it all.\n" +
+		"```\n")
+	got := CheckProseInCode(src)
+	if len(got) != 1 {
+		t.Fatalf("got %d suspect blocks, want 1: %v", len(got), got)
+	}
+	if got[0].Line != 7 {
+		t.Errorf("Line = %d, want 7", got[0].Line)
+	}
+	if got[0].ProseLines < 3 || got[0].TotalLines != 3 {
+		t.Errorf("counts = %d/%d, want 3/3", got[0].ProseLines, got[0].TotalLines)
+	}
+}
+
+func TestCheckProseInCodeIgnoresRealCode(t *testing.T) {
+	src := []byte("```go\nfunc main() {\n\tx := compute(1, 2)\n\tfmt.Println(x)\n\treturn\n}\n```\n")
+	if got := CheckProseInCode(src); len(got) != 0 {
+		t.Errorf("flagged real code: %v", got)
+	}
+}
+
+func TestCheckProseInCodeSkipsShortBlocks(t *testing.T) {
+	src := []byte("```\nThis is a single quoted sentence inside a block.\n```\n")
+	if got := CheckProseInCode(src); len(got) != 0 {
+		t.Errorf("flagged a short block: %v", got)
+	}
+}
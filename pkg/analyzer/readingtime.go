@@ -0,0 +1,35 @@
+package analyzer
+
+import "github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+
+// Default reading speeds, applied for any config.ReadingRates field
+// left at zero. Prose pace matches the common 200 wpm figure; code
+// reads far slower per line because readers step through it; headings
+// cost a beat of scanning each on top of their words.
+const (
+	defaultWordsPerMinute     = 200
+	defaultCodeLinesPerMinute = 50
+	defaultSecondsPerHeading  = 2
+)
+
+// ReadingTimeMinutes estimates how long a page takes to read, in
+// fractional minutes. Unlike a flat words/200, it prices each element
+// at its own speed: prose words at WordsPerMinute, code lines at
+// CodeLinesPerMinute, and a SecondsPerHeading scanning cost per
+// heading (heading words already sit in the prose count; this is the
+// pause, not the reading). Zero rates fall back to the defaults above.
+func ReadingTimeMinutes(words, codeLines, headings int, rates config.ReadingRates) float64 {
+	wpm := rates.WordsPerMinute
+	if wpm <= 0 {
+		wpm = defaultWordsPerMinute
+	}
+	clpm := rates.CodeLinesPerMinute
+	if clpm <= 0 {
+		clpm = defaultCodeLinesPerMinute
+	}
+	sph := rates.SecondsPerHeading
+	if sph <= 0 {
+		sph = defaultSecondsPerHeading
+	}
+	return float64(words)/wpm + float64(codeLines)/clpm + float64(headings)*sph/60
+}
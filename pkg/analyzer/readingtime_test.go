@@ -0,0 +1,23 @@
+package analyzer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestReadingTimeMinutes(t *testing.T) {
+	// 400 words at 200 wpm, 100 code lines at 50/min, 6 headings at 2s.
+	got := ReadingTimeMinutes(400, 100, 6, config.ReadingRates{})
+	want := 2.0 + 2.0 + 0.2
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("ReadingTimeMinutes = %v, want %v", got, want)
+	}
+
+	custom := config.ReadingRates{WordsPerMinute: 100, CodeLinesPerMinute: 25, SecondsPerHeading: 30}
+	got = ReadingTimeMinutes(100, 25, 2, custom)
+	if math.Abs(got-3.0) > 1e-9 {
+		t.Errorf("custom rates: ReadingTimeMinutes = %v, want 3", got)
+	}
+}
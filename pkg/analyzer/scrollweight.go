@@ -0,0 +1,45 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Scroll-weight costs, in line-equivalents. A rendered image occupies
+// roughly a screenful-fraction no source line count reflects, and each
+// code block adds chrome — padding, borders, a copy button — beyond
+// its literal lines.
+const (
+	imageScrollLines   = 20
+	codeBlockScrollPad = 4
+)
+
+// imagePattern matches an inline markdown image opener.
+var imagePattern = regexp.MustCompile(`!\[[^\]]*\]\(`)
+
+// ScrollWeight estimates rendered page length in line-equivalents:
+// every source line costs one, each image costs imageScrollLines, and
+// each fenced code block adds codeBlockScrollPad of chrome on top of
+// its lines. Raw line count undercounts image- and code-heavy pages;
+// this is the "how far must the reader scroll" number max_scroll_weight
+// gates on.
+func ScrollWeight(src []byte) int {
+	lines := strings.Split(string(src), "\n")
+	weight := len(lines)
+	inFence := false
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			if !inFence {
+				weight += codeBlockScrollPad
+			}
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		weight += len(imagePattern.FindAllString(line, -1)) * imageScrollLines
+	}
+	return weight
+}
@@ -0,0 +1,22 @@
+package analyzer
+
+import "testing"
+
+func TestScrollWeight(t *testing.T) {
+	plain := []byte("# T\n\nOne line of prose.\n")
+	if got, want := ScrollWeight(plain), 4; got != want {
+		t.Errorf("plain ScrollWeight = %d, want %d", got, want)
+	}
+
+	// Same line count, but an image and a code block render far longer.
+	heavy := []byte("# T\n\n![diagram](a.png)\n\n```bash\necho hi\n```\n")
+	if got, want := ScrollWeight(heavy), 8+imageScrollLines+codeBlockScrollPad; got != want {
+		t.Errorf("heavy ScrollWeight = %d, want %d", got, want)
+	}
+
+	// Image syntax inside a fence is code, not a rendered image.
+	fenced := []byte("```text\n![not an image](a.png)\n```\n")
+	if got, want := ScrollWeight(fenced), 4+codeBlockScrollPad; got != want {
+		t.Errorf("fenced ScrollWeight = %d, want %d", got, want)
+	}
+}
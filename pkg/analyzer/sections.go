@@ -0,0 +1,75 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CheckEmptySections flags headings with no content before the next
+// heading of the same or shallower depth (a deeper heading means the
+// section has subsections as content), and files that are nothing but
+// headings.
+// These are outline stubs: they pass readability checks because there
+// is almost no prose to score, yet they are exactly the pages with a
+// content gap.
+func CheckEmptySections(src []byte) []string {
+	var out []string
+	inFence := false
+
+	type heading struct {
+		line  int
+		level int
+		text  string
+	}
+	var open *heading
+	headings := 0
+	contentWords := 0
+
+	flush := func() {
+		if open != nil {
+			out = append(out, fmt.Sprintf("%s (line %d)", open.text, open.line))
+			open = nil
+		}
+	}
+
+	inFrontmatter := false
+	for i, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if i == 0 && trimmed == "---" {
+			inFrontmatter = true
+			continue
+		}
+		if inFrontmatter {
+			if trimmed == "---" {
+				inFrontmatter = false
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			open = nil // a code block is content for the section
+			continue
+		}
+		if inFence || trimmed == "" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			if open != nil && level > open.level {
+				open = nil // subsection content
+			}
+			flush()
+			headings++
+			open = &heading{line: i + 1, level: level, text: trimmed}
+			continue
+		}
+		open = nil
+		contentWords += len(strings.Fields(trimmed))
+	}
+	flush()
+
+	if headings > 0 && contentWords < 10 {
+		out = append(out, "file is heading-only")
+	}
+	return out
+}
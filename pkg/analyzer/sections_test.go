@@ -0,0 +1,43 @@
+package analyzer
+
+import "testing"
+
+func TestCheckEmptySections(t *testing.T) {
+	src := []byte(`---
+title: Stub
+---
+# Page
+
+## Setup
+
+## Usage
+
+Run the tool against your docs tree and read the report carefully.
+
+## Reference
+`)
+	got := CheckEmptySections(src)
+	want := []string{"## Setup (line 6)", "## Reference (line 12)"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("section %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+func TestCheckEmptySectionsHeadingOnly(t *testing.T) {
+	got := CheckEmptySections([]byte("# One\n\n## Two\n"))
+	if len(got) == 0 || got[len(got)-1] != "file is heading-only" {
+		t.Errorf("got %v, want trailing heading-only finding", got)
+	}
+}
+
+func TestCheckEmptySectionsCodeIsContent(t *testing.T) {
+	got := CheckEmptySections([]byte("# Examples\n\n```bash\necho content\n```\n\nSome closing prose, long enough to avoid the heading-only case here.\n"))
+	if len(got) != 0 {
+		t.Errorf("got %v, want none: a code block is content", got)
+	}
+}
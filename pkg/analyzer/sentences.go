@@ -0,0 +1,80 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Sentence is one sentence of a document's prose with its individual
+// readability score.
+type Sentence struct {
+	Text string `json:"text"`
+
+	// Grade is the Flesch-Kincaid grade of this sentence alone.
+	Grade float64 `json:"grade"`
+
+	// Start and End are the sentence's byte span in the original
+	// source and Line its starting line, resolved by Locator so
+	// editors can underline the exact range. All zero when the
+	// sentence could not be located after prose normalization.
+	Start int `json:"start,omitempty"`
+	End   int `json:"end,omitempty"`
+	Line  int `json:"line,omitempty"`
+}
+
+// LongSentence is a sentence over the max_sentence_words cap, with its
+// word count and source location.
+type LongSentence struct {
+	Text  string `json:"text"`
+	Words int    `json:"words"`
+	Start int    `json:"start,omitempty"`
+	End   int    `json:"end,omitempty"`
+	Line  int    `json:"line,omitempty"`
+}
+
+// String renders the sentence for issue listings, leading with the
+// word count and truncating the text so table output stays one line.
+func (s LongSentence) String() string {
+	words := strings.Fields(s.Text)
+	text := s.Text
+	if len(words) > 12 {
+		text = strings.Join(words[:12], " ") + " ..."
+	}
+	return fmt.Sprintf("%d-word sentence: %s", s.Words, text)
+}
+
+// CheckLongSentences lists the sentences in prose over maxWords words.
+func CheckLongSentences(prose string, abbrevs map[string]bool, maxWords int) []LongSentence {
+	return CheckLongSentencesWith(prose, RuleSplitter{Abbreviations: abbrevs}, maxWords)
+}
+
+// CheckLongSentencesWith is CheckLongSentences splitting with an
+// explicit SentenceSplitter.
+func CheckLongSentencesWith(prose string, split SentenceSplitter, maxWords int) []LongSentence {
+	var out []LongSentence
+	for _, s := range split.Split(prose) {
+		if n := len(strings.Fields(s)); n > maxWords {
+			out = append(out, LongSentence{Text: s, Words: n})
+		}
+	}
+	return out
+}
+
+// ScoreSentences splits prose and scores each sentence independently.
+// Single-sentence grades are noisy in absolute terms but show exactly
+// where complexity spikes within a page.
+func ScoreSentences(prose string, syllableOverrides map[string]int) []Sentence {
+	return ScoreSentencesWith(prose, ScoreOptions{SyllableOverrides: syllableOverrides})
+}
+
+// ScoreSentencesWith is ScoreSentences under full ScoreOptions, so
+// abbreviation lists and language formulas carry through to the
+// per-sentence scores.
+func ScoreSentencesWith(prose string, o ScoreOptions) []Sentence {
+	var out []Sentence
+	for _, s := range o.splitter().Split(prose) {
+		m := ScoreText(s, o)
+		out = append(out, Sentence{Text: s, Grade: m.FleschKincaid})
+	}
+	return out
+}
@@ -0,0 +1,120 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// spellingVariants maps US spellings to their British counterparts.
+// Curated for docs prose: pairs where one side is ambiguous in the
+// other dialect (license/licence, meter, program, dialog) are left out
+// so the check never flags a correct word.
+var spellingVariants = map[string]string{
+	"analyze": "analyse", "analyzed": "analysed", "analyzing": "analysing",
+	"artifact": "artefact", "artifacts": "artefacts",
+	"behavior": "behaviour", "behaviors": "behaviours",
+	"canceled": "cancelled", "canceling": "cancelling",
+	"catalog": "catalogue", "catalogs": "catalogues",
+	"center": "centre", "centered": "centred", "centers": "centres",
+	"color": "colour", "colored": "coloured", "coloring": "colouring", "colors": "colours",
+	"customization": "customisation", "customize": "customise", "customized": "customised",
+	"defense": "defence",
+	"favor":   "favour", "favorite": "favourite", "favorites": "favourites",
+	"fiber":  "fibre",
+	"flavor": "flavour", "flavors": "flavours",
+	"gray":  "grey",
+	"honor": "honour", "humor": "humour",
+	"initialization": "initialisation", "initialize": "initialise", "initialized": "initialised",
+	"labeled": "labelled", "labeling": "labelling",
+	"labor":   "labour",
+	"modeled": "modelled", "modeling": "modelling",
+	"neighbor": "neighbour", "neighbors": "neighbours",
+	"normalization": "normalisation", "normalize": "normalise", "normalized": "normalised",
+	"optimization": "optimisation", "optimizations": "optimisations",
+	"optimize": "optimise", "optimized": "optimised",
+	"organization": "organisation", "organizations": "organisations",
+	"organize": "organise", "organized": "organised", "organizing": "organising",
+	"recognize": "recognise", "recognized": "recognised",
+	"synchronization": "synchronisation", "synchronize": "synchronise", "synchronized": "synchronised",
+	"traveled": "travelled", "traveling": "travelling",
+}
+
+// ukToUS is the reverse map, built once at init.
+var ukToUS = func() map[string]string {
+	m := make(map[string]string, len(spellingVariants))
+	for us, uk := range spellingVariants {
+		m[uk] = us
+	}
+	return m
+}()
+
+// CheckSpellingVariants flags US/UK spelling inconsistency in prose,
+// fence-aware and case-insensitive. With preference "us" or "uk" every
+// occurrence of the other dialect is flagged; with no preference a file
+// is only flagged when it mixes both dialects, and the minority side's
+// occurrences are reported (ties flag the British forms, matching the
+// corpus's US-leaning defaults).
+func CheckSpellingVariants(src []byte, preference string) []string {
+	type hit struct {
+		line      int
+		word      string
+		preferred string
+		us        bool
+	}
+	var hits []hit
+	usCount, ukCount := 0, 0
+
+	lines := strings.Split(string(src), "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, field := range strings.Fields(line) {
+			word := strings.ToLower(strings.TrimFunc(field, func(r rune) bool {
+				return !unicode.IsLetter(r)
+			}))
+			if uk, ok := spellingVariants[word]; ok {
+				usCount++
+				hits = append(hits, hit{line: i + 1, word: word, preferred: uk, us: true})
+			} else if us, ok := ukToUS[word]; ok {
+				ukCount++
+				hits = append(hits, hit{line: i + 1, word: word, preferred: us})
+			}
+		}
+	}
+
+	var out []string
+	switch strings.ToLower(preference) {
+	case "us":
+		for _, h := range hits {
+			if !h.us {
+				out = append(out, fmt.Sprintf("%q at line %d: prefer the US spelling %q", h.word, h.line, h.preferred))
+			}
+		}
+	case "uk":
+		for _, h := range hits {
+			if h.us {
+				out = append(out, fmt.Sprintf("%q at line %d: prefer the British spelling %q", h.word, h.line, h.preferred))
+			}
+		}
+	default:
+		if usCount == 0 || ukCount == 0 {
+			return nil
+		}
+		flagUS := usCount < ukCount
+		for _, h := range hits {
+			if h.us == flagUS {
+				out = append(out, fmt.Sprintf("%q at line %d mixes dialects: the file mostly spells these the other way (%q)",
+					h.word, h.line, h.preferred))
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,41 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckSpellingVariantsPreference(t *testing.T) {
+	src := []byte("# T\n\nThe colour of the behavior matters.\n")
+
+	us := CheckSpellingVariants(src, "us")
+	if len(us) != 1 || !strings.Contains(us[0], `"colour" at line 3`) || !strings.Contains(us[0], `"color"`) {
+		t.Errorf("preference us = %v, want colour flagged with color suggested", us)
+	}
+
+	uk := CheckSpellingVariants(src, "uk")
+	if len(uk) != 1 || !strings.Contains(uk[0], `"behavior" at line 3`) || !strings.Contains(uk[0], `"behaviour"`) {
+		t.Errorf("preference uk = %v, want behavior flagged with behaviour suggested", uk)
+	}
+}
+
+func TestCheckSpellingVariantsMixing(t *testing.T) {
+	// No preference: a single-dialect file passes however it spells.
+	clean := []byte("The colour and behaviour of the organisation.\n")
+	if got := CheckSpellingVariants(clean, ""); len(got) != 0 {
+		t.Errorf("consistent UK file flagged: %v", got)
+	}
+
+	// A mixed file flags the minority dialect's occurrences.
+	mixed := []byte("Set the color here.\n\nThe color scheme works.\n\nMind the colour too.\n")
+	got := CheckSpellingVariants(mixed, "")
+	if len(got) != 1 || !strings.Contains(got[0], `"colour" at line 5`) {
+		t.Errorf("mixed file = %v, want the lone UK spelling flagged", got)
+	}
+
+	// Variants inside code fences are identifiers, not prose.
+	fenced := []byte("The color scheme.\n\n```css\n.colour { color: red }\n```\n")
+	if got := CheckSpellingVariants(fenced, ""); len(got) != 0 {
+		t.Errorf("fenced variant flagged: %v", got)
+	}
+}
@@ -0,0 +1,84 @@
+package analyzer
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SentenceSplitter breaks prose into sentences. Every sentence-based
+// computation — the readability formulas' sentence counts, long-sentence
+// checks, per-sentence scores, repeated-opening detection — routes
+// through one splitter, so swapping in a smarter implementation (an NLP
+// tokenizer, say) improves all of them at once. The default is
+// RuleSplitter; embedders set Analyzer.Splitter or
+// ScoreOptions.Splitter to override it.
+type SentenceSplitter interface {
+	Split(prose string) []string
+}
+
+// RuleSplitter is the default SentenceSplitter: terminal punctuation
+// ends a sentence, with rules for the common false positives.
+// Abbreviations ("e.g.", "approx.") do not end sentences, decimals and
+// version numbers stay intact, and closing quotes or parentheses after
+// the terminator stay attached. Block boundaries alone do not end a
+// sentence: reference pages full of unpunctuated fragments score as
+// long sentences rather than many short ones, which the thresholds
+// account for per section.
+type RuleSplitter struct {
+	// Abbreviations is the abbreviation set consulted before breaking
+	// on a period. Nil means the built-in defaults; see
+	// AbbreviationSet for loading domain lists.
+	Abbreviations map[string]bool
+}
+
+// Split implements SentenceSplitter.
+func (rs RuleSplitter) Split(prose string) []string {
+	abbrevs := rs.Abbreviations
+	if abbrevs == nil {
+		abbrevs = defaultAbbrevSet
+	}
+	var out []string
+	var sb strings.Builder
+	runes := []rune(prose)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		sb.WriteRune(r)
+		if r != '.' && r != '!' && r != '?' {
+			continue
+		}
+		if r == '.' && abbrevs[lastWord(sb.String())] {
+			continue
+		}
+		// Consume trailing closers like quotes or parens.
+		for i+1 < len(runes) && strings.ContainsRune(")\"'", runes[i+1]) {
+			i++
+			sb.WriteRune(runes[i])
+		}
+		// Only break when followed by whitespace or end of input, so
+		// decimals and version numbers stay intact.
+		if i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			continue
+		}
+		if s := strings.TrimSpace(sb.String()); s != "" {
+			out = append(out, s)
+		}
+		sb.Reset()
+	}
+	if s := strings.TrimSpace(sb.String()); s != "" {
+		out = append(out, s)
+	}
+	return out
+}
+
+// lastWord returns the lowercase final token of s with leading
+// punctuation stripped, for abbreviation lookups mid-split.
+func lastWord(s string) string {
+	i := len(s)
+	for i > 0 && !unicode.IsSpace(rune(s[i-1])) {
+		i--
+	}
+	w := strings.TrimLeftFunc(s[i:], func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.ToLower(w)
+}
@@ -0,0 +1,55 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+// lineSplitter treats every non-blank line as a sentence, standing in
+// for an embedder-supplied splitter.
+type lineSplitter struct{}
+
+func (lineSplitter) Split(prose string) []string {
+	var out []string
+	for _, l := range strings.Split(prose, "\n") {
+		if s := strings.TrimSpace(l); s != "" {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func TestScoreTextCustomSplitter(t *testing.T) {
+	prose := "first fragment without punctuation\nsecond fragment without punctuation\nthird fragment without punctuation"
+
+	def := ScoreText(prose, ScoreOptions{})
+	if def.Sentences != 1 {
+		t.Fatalf("default splitter sentences = %d, want 1", def.Sentences)
+	}
+	got := ScoreText(prose, ScoreOptions{Splitter: lineSplitter{}})
+	if got.Sentences != 3 {
+		t.Errorf("custom splitter sentences = %d, want 3", got.Sentences)
+	}
+}
+
+func TestCheckLongSentencesWithCustomSplitter(t *testing.T) {
+	prose := "one two three four five\nsix seven"
+	got := CheckLongSentencesWith(prose, lineSplitter{}, 3)
+	if len(got) != 1 || got[0].Words != 5 {
+		t.Errorf("CheckLongSentencesWith = %+v, want one 5-word sentence", got)
+	}
+}
+
+func TestRuleSplitterMatchesSplitSentences(t *testing.T) {
+	prose := "This is one. See e.g. section 2.3 for details! Done?"
+	want := SplitSentences(prose)
+	got := RuleSplitter{}.Split(prose)
+	if len(got) != len(want) {
+		t.Fatalf("RuleSplitter.Split = %v, SplitSentences = %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("sentence %d: %q != %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Stats holds corpus-wide aggregates used for understanding a docs tree
+// and choosing realistic thresholds, as opposed to gating it.
+type Stats struct {
+	Files      int `json:"files"`
+	TotalWords int `json:"total_words"`
+
+	AvgLines float64 `json:"avg_lines"`
+
+	MedianGrade float64 `json:"median_grade"`
+	P90Grade    float64 `json:"p90_grade"`
+
+	MedianCodeRatio float64 `json:"median_code_ratio"`
+	P90CodeRatio    float64 `json:"p90_code_ratio"`
+
+	// HeadingsByLevel totals headings across the corpus, index 0
+	// holding H1s.
+	HeadingsByLevel [6]int `json:"headings_by_level"`
+
+	// Distribution buckets files by DistributionMetric; see
+	// ComputeDistribution.
+	DistributionMetric string       `json:"distribution_metric,omitempty"`
+	Distribution       []DistBucket `json:"distribution,omitempty"`
+}
+
+// DistBucket is one band of the readability distribution with the
+// number of files that fall into it.
+type DistBucket struct {
+	Label string `json:"label"`
+	Count int    `json:"count"`
+}
+
+// easeBands are the conventional Flesch reading-ease interpretation
+// bands, easiest first. Scores below every band are "Very difficult".
+var easeBands = []struct {
+	min   float64
+	label string
+}{
+	{90, "Very easy"},
+	{70, "Easy"},
+	{60, "Standard"},
+	{50, "Fairly difficult"},
+	{30, "Difficult"},
+}
+
+// ComputeDistribution buckets non-skipped results by the named metric:
+// "grade" uses the Flesch-Kincaid reading-level bands so the
+// distribution agrees with what --check gates on, "ease" the classic
+// Flesch reading-ease bands.
+func ComputeDistribution(results []*Result, metric string) ([]DistBucket, error) {
+	var labels []string
+	labelFor := func(m Metrics) string { return "" }
+	switch metric {
+	case "grade":
+		for _, b := range defaultGradeBands {
+			labels = append(labels, b.Label)
+		}
+		labels = append(labels, "Professional")
+		labelFor = func(m Metrics) string { return GradeLabel(m.FleschKincaid, nil) }
+	case "ease":
+		for _, b := range easeBands {
+			labels = append(labels, b.label)
+		}
+		labels = append(labels, "Very difficult")
+		labelFor = func(m Metrics) string {
+			for _, b := range easeBands {
+				if m.FleschEase >= b.min {
+					return b.label
+				}
+			}
+			return "Very difficult"
+		}
+	default:
+		return nil, fmt.Errorf("unknown distribution metric %q (want grade or ease)", metric)
+	}
+
+	counts := make(map[string]int)
+	for _, r := range results {
+		if r.Status == StatusSkipped || r.Status == StatusError {
+			continue
+		}
+		counts[labelFor(r.Metrics)]++
+	}
+	out := make([]DistBucket, 0, len(labels))
+	for _, l := range labels {
+		out = append(out, DistBucket{Label: l, Count: counts[l]})
+	}
+	return out, nil
+}
+
+// ComputeStats aggregates non-skipped results. Percentiles use linear
+// interpolation between closest ranks.
+func ComputeStats(results []*Result) Stats {
+	var s Stats
+	var grades, ratios []float64
+	totalLines := 0
+	for _, r := range results {
+		if r.Status == StatusSkipped {
+			continue
+		}
+		s.Files++
+		s.TotalWords += r.Metrics.Words
+		totalLines += r.Metrics.Lines
+		grades = append(grades, r.Metrics.FleschKincaid)
+		ratios = append(ratios, r.Metrics.CodeRatio)
+		for i, n := range r.Metrics.HeadingsByLevel {
+			s.HeadingsByLevel[i] += n
+		}
+	}
+	if s.Files == 0 {
+		return s
+	}
+	s.AvgLines = float64(totalLines) / float64(s.Files)
+	s.MedianGrade = Percentile(grades, 50)
+	s.P90Grade = Percentile(grades, 90)
+	s.MedianCodeRatio = Percentile(ratios, 50)
+	s.P90CodeRatio = Percentile(ratios, 90)
+	return s
+}
+
+// Percentile returns the p-th percentile (0-100) of values using
+// linear interpolation between closest ranks. Values need not be
+// sorted; an empty slice yields zero.
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(rank)
+	if lo >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	frac := rank - float64(lo)
+	return sorted[lo]*(1-frac) + sorted[lo+1]*frac
+}
@@ -0,0 +1,78 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SyntaxIssue is one well-formedness problem in a markdown source:
+// markup that parsers recover from silently but renders wrong, like an
+// unclosed fence swallowing the rest of the page. Distinct from the
+// structural checks — this is about the file being valid markdown at
+// all.
+type SyntaxIssue struct {
+	Line    int    `json:"line"`
+	Message string `json:"message"`
+}
+
+// refLink matches a reference-style link use and captures its label;
+// refDef a definition line and its label.
+var (
+	refLink = regexp.MustCompile(`\[([^\]]+)\]\[([^\]]*)\]`)
+	refDef  = regexp.MustCompile(`^\s*\[([^\]]+)\]:\s*\S`)
+)
+
+// CheckMarkdownSyntax validates that src is well-formed markdown the
+// conservative way: only constructs that are unambiguously broken are
+// flagged, so a clean CommonMark file never reports. It catches
+// unclosed code fences, unclosed inline code spans, unclosed strong
+// emphasis, and reference links with no matching definition.
+func CheckMarkdownSyntax(src []byte) []SyntaxIssue {
+	var out []SyntaxIssue
+	lines := strings.Split(string(src), "\n")
+
+	defs := map[string]bool{}
+	for _, line := range lines {
+		if m := refDef.FindStringSubmatch(line); m != nil {
+			defs[strings.ToLower(m[1])] = true
+		}
+	}
+
+	inFence := false
+	fenceLine := 0
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			if inFence {
+				fenceLine = i + 1
+			}
+			continue
+		}
+		if inFence || refDef.MatchString(line) {
+			continue
+		}
+		if strings.Count(line, "`")%2 != 0 {
+			out = append(out, SyntaxIssue{Line: i + 1, Message: "unclosed inline code span (odd number of backticks)"})
+			continue
+		}
+		scrubbed := inlineCodeSpan.ReplaceAllString(line, "")
+		if strings.Count(scrubbed, "**")%2 != 0 {
+			out = append(out, SyntaxIssue{Line: i + 1, Message: "unclosed strong emphasis (unbalanced **)"})
+		}
+		for _, m := range refLink.FindAllStringSubmatch(scrubbed, -1) {
+			label := m[2]
+			if label == "" {
+				label = m[1]
+			}
+			if !defs[strings.ToLower(label)] {
+				out = append(out, SyntaxIssue{Line: i + 1, Message: fmt.Sprintf("reference link [%s] has no definition", label)})
+			}
+		}
+	}
+	if inFence {
+		out = append(out, SyntaxIssue{Line: fenceLine, Message: "code fence is never closed"})
+	}
+	return out
+}
@@ -0,0 +1,48 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckMarkdownSyntaxClean(t *testing.T) {
+	src := []byte("# Title\n\nSome prose with `code` and **bold** text and a [link][ref].\n\n[ref]: https://example.com\n\n```bash\necho ok\n```\n")
+	if got := CheckMarkdownSyntax(src); len(got) != 0 {
+		t.Fatalf("CheckMarkdownSyntax on clean file = %+v, want none", got)
+	}
+}
+
+func TestCheckMarkdownSyntaxIssues(t *testing.T) {
+	cases := []struct {
+		name string
+		src  string
+		line int
+		want string
+	}{
+		{"unclosed span", "# T\n\nAn `unclosed span here.\n", 3, "inline code span"},
+		{"unbalanced strong", "# T\n\nSome **bold that never closes.\n", 3, "strong emphasis"},
+		{"undefined ref", "# T\n\nSee [the guide][missing].\n", 3, "[missing] has no definition"},
+		{"shortcut ref", "# T\n\nSee [guide][].\n", 3, "[guide] has no definition"},
+		{"unclosed fence", "# T\n\n```bash\necho trapped\n", 3, "never closed"},
+	}
+	for _, tc := range cases {
+		got := CheckMarkdownSyntax([]byte(tc.src))
+		if len(got) != 1 {
+			t.Errorf("%s: CheckMarkdownSyntax = %+v, want 1 issue", tc.name, got)
+			continue
+		}
+		if got[0].Line != tc.line {
+			t.Errorf("%s: issue at line %d, want %d", tc.name, got[0].Line, tc.line)
+		}
+		if !strings.Contains(got[0].Message, tc.want) {
+			t.Errorf("%s: message %q, want substring %q", tc.name, got[0].Message, tc.want)
+		}
+	}
+}
+
+func TestCheckMarkdownSyntaxIgnoresFences(t *testing.T) {
+	src := []byte("# T\n\n```text\nodd ` backtick and **half bold inside a fence\n```\n")
+	if got := CheckMarkdownSyntax(src); len(got) != 0 {
+		t.Fatalf("CheckMarkdownSyntax inside fences = %+v, want none", got)
+	}
+}
@@ -0,0 +1,96 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// separatorCell matches one cell of a GFM table separator row: dashes
+// with optional alignment colons.
+var separatorCell = regexp.MustCompile(`^:?-+:?$`)
+
+// CheckTables validates GFM table structure in raw markdown: a header
+// row, a separator row beneath it, and a consistent column count in
+// every row. Misaligned tables render badly and are easy to introduce
+// when editing a wide reference table; no readability metric catches
+// them.
+func CheckTables(src []byte) []string {
+	var out []string
+	lines := strings.Split(string(src), "\n")
+	inFence := false
+	for i := 0; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence || !isTableRow(trimmed) {
+			continue
+		}
+
+		header := len(tableCells(trimmed))
+		next := ""
+		if i+1 < len(lines) {
+			next = strings.TrimSpace(lines[i+1])
+		}
+		if !isSeparatorRow(next) {
+			// Two stacked pipe rows with no separator between them is
+			// a table that lost its separator; a lone pipe line is
+			// just prose.
+			if isTableRow(next) && header >= 2 {
+				out = append(out, fmt.Sprintf("table at line %d is missing its separator row", i+1))
+				for i+1 < len(lines) && isTableRow(strings.TrimSpace(lines[i+1])) {
+					i++
+				}
+			}
+			continue
+		}
+
+		if sep := len(tableCells(next)); sep != header {
+			out = append(out, fmt.Sprintf("table at line %d: separator has %d columns, header has %d",
+				i+1, sep, header))
+		}
+		j := i + 2
+		for ; j < len(lines); j++ {
+			row := strings.TrimSpace(lines[j])
+			if !isTableRow(row) {
+				break
+			}
+			if n := len(tableCells(row)); n != header {
+				out = append(out, fmt.Sprintf("table row at line %d has %d columns, header has %d",
+					j+1, n, header))
+			}
+		}
+		i = j - 1
+	}
+	return out
+}
+
+// isTableRow reports whether a trimmed line looks like a table row:
+// it contains an unescaped pipe.
+func isTableRow(trimmed string) bool {
+	return strings.Contains(strings.ReplaceAll(trimmed, `\|`, ""), "|")
+}
+
+// isSeparatorRow reports whether a trimmed line is a table separator:
+// every cell is dashes with optional alignment colons.
+func isSeparatorRow(trimmed string) bool {
+	if !isTableRow(trimmed) {
+		return false
+	}
+	for _, cell := range tableCells(trimmed) {
+		if !separatorCell.MatchString(strings.TrimSpace(cell)) {
+			return false
+		}
+	}
+	return true
+}
+
+// tableCells splits a trimmed row into its cells, tolerating present
+// or absent outer pipes.
+func tableCells(trimmed string) []string {
+	trimmed = strings.TrimPrefix(trimmed, "|")
+	trimmed = strings.TrimSuffix(trimmed, "|")
+	return strings.Split(trimmed, "|")
+}
@@ -0,0 +1,61 @@
+package analyzer
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckTablesCleanTable(t *testing.T) {
+	src := []byte(`# Doc
+
+| Name | Value |
+|------|-------|
+| a    | 1     |
+| b    | 2     |
+`)
+	if issues := CheckTables(src); len(issues) != 0 {
+		t.Errorf("issues = %v, want none", issues)
+	}
+}
+
+func TestCheckTablesMissingSeparator(t *testing.T) {
+	src := []byte(`| Name | Value |
+| a    | 1     |
+`)
+	issues := CheckTables(src)
+	if len(issues) != 1 || !strings.Contains(issues[0], "missing its separator row") {
+		t.Errorf("issues = %v, want one missing-separator issue", issues)
+	}
+	if !strings.Contains(issues[0], "line 1") {
+		t.Errorf("issue %q does not name line 1", issues[0])
+	}
+}
+
+func TestCheckTablesRaggedColumns(t *testing.T) {
+	src := []byte(`| Name | Value |
+|------|-------|
+| a    | 1     | extra |
+| b    | 2     |
+`)
+	issues := CheckTables(src)
+	if len(issues) != 1 || !strings.Contains(issues[0], "line 3") {
+		t.Errorf("issues = %v, want one ragged-row issue at line 3", issues)
+	}
+}
+
+func TestCheckTablesSeparatorMismatch(t *testing.T) {
+	src := []byte(`| Name | Value |
+|------|
+`)
+	issues := CheckTables(src)
+	if len(issues) != 1 || !strings.Contains(issues[0], "separator has 1 columns, header has 2") {
+		t.Errorf("issues = %v, want a separator-mismatch issue", issues)
+	}
+}
+
+func TestCheckTablesIgnoresFencesAndProse(t *testing.T) {
+	src := []byte("Prose with a pipe | in it.\n\n```\n| not | a | table |\n| still | not | one |\n```\n")
+	if issues := CheckTables(src); len(issues) != 0 {
+		t.Errorf("issues = %v, want none", issues)
+	}
+}
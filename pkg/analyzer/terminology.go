@@ -0,0 +1,88 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+// TermIssue is one disallowed term found in prose, with the preferred
+// form the style guide mandates instead.
+type TermIssue struct {
+	Term      string `json:"term"`
+	Preferred string `json:"preferred"`
+	Line      int    `json:"line"`
+}
+
+// String renders the issue for listings.
+func (t TermIssue) String() string {
+	return fmt.Sprintf("use %q, not %q", t.Preferred, t.Term)
+}
+
+// CheckTerminology scans raw markdown for the disallowed variants in
+// the configured terminology rules. Matching is word-boundary and
+// case-insensitive unless a rule opts into case sensitivity for proper
+// nouns; code fences are exempt, since commands and identifiers are
+// not prose.
+func CheckTerminology(src []byte, rules []config.TermRule) []TermIssue {
+	if len(rules) == 0 {
+		return nil
+	}
+	var out []TermIssue
+	inFence := false
+	for i, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		for _, rule := range rules {
+			for _, v := range rule.Variants {
+				for range termMatches(line, v, rule.CaseSensitive) {
+					out = append(out, TermIssue{Term: v, Preferred: rule.Preferred, Line: i + 1})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// termMatches returns the start columns of word-boundary occurrences
+// of term in line.
+func termMatches(line, term string, caseSensitive bool) []int {
+	if term == "" {
+		return nil
+	}
+	hay, needle := line, term
+	if !caseSensitive {
+		hay, needle = strings.ToLower(line), strings.ToLower(term)
+	}
+	var cols []int
+	for at := 0; ; {
+		j := strings.Index(hay[at:], needle)
+		if j < 0 {
+			return cols
+		}
+		at += j
+		end := at + len(needle)
+		if boundaryAt(hay, at-1) && boundaryAt(hay, end) {
+			cols = append(cols, at)
+		}
+		at = end
+	}
+}
+
+// boundaryAt reports whether position i in s is outside the string or
+// holds a non-word rune, i.e. a valid word boundary.
+func boundaryAt(s string, i int) bool {
+	if i < 0 || i >= len(s) {
+		return true
+	}
+	r := rune(s[i])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
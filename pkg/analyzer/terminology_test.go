@@ -0,0 +1,42 @@
+package analyzer
+
+import (
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+func TestCheckTerminology(t *testing.T) {
+	rules := []config.TermRule{
+		{Preferred: "sign-in", Variants: []string{"login", "log-in"}},
+		{Preferred: "GitHub", Variants: []string{"Github"}, CaseSensitive: true},
+	}
+	src := []byte(`# Access
+
+Use the Login page to authenticate.
+
+Push the repo to Github after review. The github CLI is unaffected.
+
+` + "```bash\nlogin --user admin\n```\n")
+
+	got := CheckTerminology(src, rules)
+	if len(got) != 2 {
+		t.Fatalf("got %d issues, want 2: %+v", len(got), got)
+	}
+	if got[0].Term != "login" || got[0].Preferred != "sign-in" || got[0].Line != 3 {
+		t.Errorf("issues[0] = %+v, want login->sign-in at line 3", got[0])
+	}
+	if got[1].Term != "Github" || got[1].Line != 5 {
+		t.Errorf("issues[1] = %+v, want Github at line 5", got[1])
+	}
+}
+
+func TestCheckTerminologyWordBoundaries(t *testing.T) {
+	rules := []config.TermRule{{Preferred: "email", Variants: []string{"e-mail"}}}
+	if got := CheckTerminology([]byte("The emailer sends mail.\n"), rules); len(got) != 0 {
+		t.Errorf("got %+v, want no issues for substring non-matches", got)
+	}
+	if got := CheckTerminology([]byte("Send an E-Mail to the list.\n"), rules); len(got) != 1 {
+		t.Errorf("got %+v, want one case-insensitive match", got)
+	}
+}
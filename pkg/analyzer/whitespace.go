@@ -0,0 +1,50 @@
+package analyzer
+
+import "strings"
+
+// WhitespaceIssue is one style-guide whitespace violation.
+type WhitespaceIssue struct {
+	Line int    `json:"line"`
+	Kind string `json:"kind"`
+}
+
+// Whitespace issue kinds. The style guide bans trailing whitespace and
+// hard tabs in prose, and allows at most one consecutive blank line.
+const (
+	TrailingWhitespace    = "trailing_whitespace"
+	HardTab               = "hard_tab"
+	ConsecutiveBlankLines = "consecutive_blank_lines"
+)
+
+// CheckWhitespace scans raw markdown for whitespace style issues. Tabs
+// inside code fences are fine; trailing whitespace is flagged anywhere
+// since the site does not rely on two-space line breaks.
+func CheckWhitespace(src []byte) []WhitespaceIssue {
+	var out []WhitespaceIssue
+	inFence := false
+	blanks := 0
+	for i, line := range strings.Split(string(src), "\n") {
+		n := i + 1
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+		}
+
+		if trimmed == "" {
+			blanks++
+			if blanks == 2 {
+				out = append(out, WhitespaceIssue{Line: n, Kind: ConsecutiveBlankLines})
+			}
+		} else {
+			blanks = 0
+		}
+
+		if line != strings.TrimRight(line, " \t") && trimmed != "" {
+			out = append(out, WhitespaceIssue{Line: n, Kind: TrailingWhitespace})
+		}
+		if !inFence && strings.Contains(line, "\t") {
+			out = append(out, WhitespaceIssue{Line: n, Kind: HardTab})
+		}
+	}
+	return out
+}
@@ -0,0 +1,25 @@
+package analyzer
+
+import "testing"
+
+func TestCheckWhitespace(t *testing.T) {
+	src := []byte("# Title \n\n\nProse\twith tab\n\n```\n\tindented code is fine   \n```\n")
+	issues := CheckWhitespace(src)
+
+	want := []WhitespaceIssue{
+		{Line: 1, Kind: TrailingWhitespace},
+		{Line: 3, Kind: ConsecutiveBlankLines},
+		{Line: 4, Kind: HardTab},
+		// Trailing whitespace is flagged even in code fences; tabs
+		// there are not.
+		{Line: 7, Kind: TrailingWhitespace},
+	}
+	if len(issues) != len(want) {
+		t.Fatalf("got %+v, want %+v", issues, want)
+	}
+	for i, issue := range issues {
+		if issue != want[i] {
+			t.Errorf("issue %d = %+v, want %+v", i, issue, want[i])
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package analyzer
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// defaultAbbreviations are trailing-period abbreviations whose period
+// does not end a sentence. Domain lists load on top via
+// config.Analysis.AbbreviationsFile.
+var defaultAbbreviations = []string{
+	"e.g.", "i.e.", "etc.", "cf.", "vs.", "ca.", "approx.",
+	"no.", "fig.", "sec.", "ch.", "p.", "pp.",
+	"dr.", "mr.", "mrs.", "ms.", "prof.", "st.",
+}
+
+// defaultAbbrevSet backs the nil case in SplitSentencesWith so the
+// common path allocates the set once.
+var defaultAbbrevSet = wordSet(defaultAbbreviations, nil, false)
+
+// defaultStopwordSet backs the nil case in FindDuplicates.
+var defaultStopwordSet = wordSet(defaultStopwords, nil, false)
+
+// defaultStopwords are high-frequency words the duplicate shingler
+// drops, so boilerplate matches on its distinctive words rather than
+// on glue every page shares.
+var defaultStopwords = []string{
+	"the", "a", "an", "and", "or", "of", "to", "in", "is", "are",
+	"it", "that", "this", "for", "on", "with", "as", "by", "at", "be",
+}
+
+// LoadWordList reads a word list file: one entry per line, blank lines
+// and #-comments ignored, entries lowercased.
+func LoadWordList(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("load word list: %w", err)
+	}
+	defer f.Close()
+
+	var out []string
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		out = append(out, strings.ToLower(line))
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("load word list %s: %w", path, err)
+	}
+	return out, nil
+}
+
+// AbbreviationSet builds the abbreviation set from extra entries, on
+// top of the built-in defaults or, with replace, instead of them.
+// Entries are keyed lowercase with their trailing period intact.
+func AbbreviationSet(extra []string, replace bool) map[string]bool {
+	return wordSet(defaultAbbreviations, extra, replace)
+}
+
+// StopwordSet builds the stopword set from extra entries, on top of
+// the built-in defaults or, with replace, instead of them.
+func StopwordSet(extra []string, replace bool) map[string]bool {
+	return wordSet(defaultStopwords, extra, replace)
+}
+
+func wordSet(defaults, extra []string, replace bool) map[string]bool {
+	out := make(map[string]bool)
+	if !replace {
+		for _, w := range defaults {
+			out[strings.ToLower(w)] = true
+		}
+	}
+	for _, w := range extra {
+		out[strings.ToLower(w)] = true
+	}
+	return out
+}
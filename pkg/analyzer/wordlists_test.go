@@ -0,0 +1,72 @@
+package analyzer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSplitSentencesDefaultAbbreviations(t *testing.T) {
+	prose := "Use short names, e.g. api or cli, wherever possible. A second sentence follows."
+	got := SplitSentences(prose)
+	if len(got) != 2 {
+		t.Fatalf("got %d sentences, want 2: %q", len(got), got)
+	}
+}
+
+func TestSplitSentencesWithMerge(t *testing.T) {
+	prose := "See sect. 4 for details. The next sentence stands alone."
+	if got := SplitSentences(prose); len(got) != 3 {
+		t.Fatalf("without the abbreviation: got %d sentences, want 3: %q", len(got), got)
+	}
+	abbrevs := AbbreviationSet([]string{"sect."}, false)
+	if got := SplitSentencesWith(prose, abbrevs); len(got) != 2 {
+		t.Fatalf("merged: got %d sentences, want 2: %q", len(got), got)
+	}
+	// Merging keeps the defaults alongside the extras.
+	if !abbrevs["e.g."] {
+		t.Error("merged set dropped the default e.g.")
+	}
+}
+
+func TestSplitSentencesWithReplace(t *testing.T) {
+	abbrevs := AbbreviationSet([]string{"sect."}, true)
+	if abbrevs["e.g."] {
+		t.Error("replaced set still contains the default e.g.")
+	}
+	prose := "Short names, e.g. api, are fine. Second sentence."
+	// With the defaults replaced, the period after e.g. ends a sentence.
+	if got := SplitSentencesWith(prose, abbrevs); len(got) != 3 {
+		t.Fatalf("got %d sentences, want 3: %q", len(got), got)
+	}
+}
+
+func TestLoadWordList(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "words.txt")
+	content := "# domain abbreviations\nSect.\n\nresp.\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	words, err := LoadWordList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(words) != 2 || words[0] != "sect." || words[1] != "resp." {
+		t.Errorf("LoadWordList = %v, want [sect. resp.]", words)
+	}
+}
+
+func TestShingleDropsStopwords(t *testing.T) {
+	// The two texts differ only in stopwords, so their shingles match
+	// once the glue is removed.
+	a := shingle("the broker issues a short-lived token for the deploy job queue", nil)
+	b := shingle("broker issues short-lived token for deploy job queue", nil)
+	if len(a) == 0 {
+		t.Fatal("no shingles produced")
+	}
+	for s := range a {
+		if _, ok := b[s]; !ok {
+			t.Fatalf("shingle sets differ despite only stopword changes")
+		}
+	}
+}
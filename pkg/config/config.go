@@ -0,0 +1,660 @@
+// Package config loads the analyzer configuration, conventionally kept
+// in .readability.yml at the repository root.
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultPath is where the configuration lives unless overridden.
+const DefaultPath = ".readability.yml"
+
+// Thresholds are the per-file limits a document is checked against.
+// Fields are pointers so overrides can change one limit without
+// restating the rest; a nil field disables that check.
+type Thresholds struct {
+	MaxGrade *float64 `yaml:"max_grade,omitempty" json:"max_grade,omitempty"`
+	MaxARI   *float64 `yaml:"max_ari,omitempty" json:"max_ari,omitempty"`
+	MaxFog   *float64 `yaml:"max_fog,omitempty" json:"max_fog,omitempty"`
+	MinEase  *float64 `yaml:"min_ease,omitempty" json:"min_ease,omitempty"`
+
+	// MaxColemanLiau and MaxSMOG are off by default: configs written
+	// before these gates existed tuned their overrides for the original
+	// four formulas, and enabling two more limits by default would fail
+	// those trees without any config change.
+	MaxColemanLiau *float64 `yaml:"max_coleman_liau,omitempty" json:"max_coleman_liau,omitempty"`
+	MaxSMOG        *float64 `yaml:"max_smog,omitempty" json:"max_smog,omitempty"`
+
+	MaxLines *int `yaml:"max_lines,omitempty" json:"max_lines,omitempty"`
+	MinWords *int `yaml:"min_words,omitempty" json:"min_words,omitempty"`
+
+	// MaxListDepth caps bullet/numbered list nesting; deeper lists
+	// read as structure problems the prose formulas miss.
+	MaxListDepth *int `yaml:"max_list_depth,omitempty" json:"max_list_depth,omitempty"`
+
+	// MaxScrollWeight caps estimated rendered page length in
+	// line-equivalents, with images and code-block chrome priced in;
+	// see analyzer.ScrollWeight. Raw max_lines undercounts image- and
+	// code-heavy pages. Zero or unset disables the gate.
+	MaxScrollWeight *int `yaml:"max_scroll_weight,omitempty" json:"max_scroll_weight,omitempty"`
+
+	// MinHeadingDensity requires at least one H2 per this many lines,
+	// so long files cannot ship as one unbroken wall of text. Files
+	// shorter than the window need no H2 at all.
+	MinHeadingDensity *int `yaml:"min_heading_density,omitempty" json:"min_heading_density,omitempty"`
+
+	// MaxCodeRatio caps the share of a file's lines inside code
+	// blocks. MinProseWords fails files without enough explanatory
+	// text; unlike MinWords it is a gate, not a skip condition. Both
+	// catch pages that are essentially uncommented code.
+	MaxCodeRatio  *float64 `yaml:"max_code_ratio,omitempty" json:"max_code_ratio,omitempty"`
+	MinProseWords *int     `yaml:"min_prose_words,omitempty" json:"min_prose_words,omitempty"`
+
+	// MaxSentenceWords fails files containing any single sentence over
+	// this many words, each one listed with its line. A concrete rule
+	// writers can act on directly, unlike the aggregate grades. Zero or
+	// unset disables the gate.
+	MaxSentenceWords *int `yaml:"max_sentence_words,omitempty" json:"max_sentence_words,omitempty"`
+
+	// MaxFirstParagraphGrade fails files whose opening paragraph scores
+	// above this Flesch-Kincaid grade. The first paragraph decides
+	// whether readers continue, so it usually deserves a stricter cap
+	// than the file-wide max_grade. Zero or unset disables the check.
+	MaxFirstParagraphGrade *float64 `yaml:"max_first_paragraph_grade,omitempty" json:"max_first_paragraph_grade,omitempty"`
+
+	// MaxMetricSpread fails files whose grade-level formulas disagree
+	// by more than this many grades; a wide spread marks content worth
+	// a human look before trusting any single score. Zero or unset
+	// disables the check.
+	MaxMetricSpread *float64 `yaml:"max_metric_spread,omitempty" json:"max_metric_spread,omitempty"`
+
+	// MaxHeadingWords fails files with headings over this many words;
+	// full-sentence headings scan poorly and overflow tables of
+	// contents. Zero or unset disables the check.
+	MaxHeadingWords *int `yaml:"max_heading_words,omitempty" json:"max_heading_words,omitempty"`
+
+	// MaxConsecutiveCodeBlocks caps how many fenced code blocks may
+	// stack with no prose between them; tutorials that exceed it read
+	// as paste-and-pray. Zero or unset disables the check.
+	MaxConsecutiveCodeBlocks *int `yaml:"max_consecutive_code_blocks,omitempty" json:"max_consecutive_code_blocks,omitempty"`
+
+	// FailOnSpelling fails files with unknown words when spell
+	// checking is enabled.
+	FailOnSpelling *bool `yaml:"fail_on_spelling,omitempty" json:"fail_on_spelling,omitempty"`
+
+	// FailOnSpellingVariants fails files with inconsistent US/UK
+	// spellings; analysis.spelling_variant picks the preferred dialect,
+	// or leave it unset to flag only files that mix both.
+	FailOnSpellingVariants *bool `yaml:"fail_on_spelling_variants,omitempty" json:"fail_on_spelling_variants,omitempty"`
+
+	// FailOnWhitespace fails files with whitespace style issues when
+	// the whitespace check is enabled.
+	FailOnWhitespace *bool `yaml:"fail_on_whitespace,omitempty" json:"fail_on_whitespace,omitempty"`
+
+	// FailOnMarkdownSyntax fails files with markdown well-formedness
+	// problems when --strict-markdown is enabled.
+	FailOnMarkdownSyntax *bool `yaml:"fail_on_markdown_syntax,omitempty" json:"fail_on_markdown_syntax,omitempty"`
+
+	// FailOnEmptySections fails files with headings that have no
+	// content, or that are nothing but headings.
+	FailOnEmptySections *bool `yaml:"fail_on_empty_sections,omitempty" json:"fail_on_empty_sections,omitempty"`
+
+	// FailOnRepeatedOpenings fails files where consecutive sentences
+	// keep starting with the same word; see
+	// analysis.repeated_opening_run.
+	FailOnRepeatedOpenings *bool `yaml:"fail_on_repeated_openings,omitempty" json:"fail_on_repeated_openings,omitempty"`
+
+	// FailOnHeadingStyle fails files with setext (underlined) headings
+	// or a mix of heading styles; the style guide mandates ATX.
+	FailOnHeadingStyle *bool `yaml:"fail_on_heading_style,omitempty" json:"fail_on_heading_style,omitempty"`
+
+	// FailOnMixedListMarkers fails files mixing list marker styles:
+	// bullets switching between -, *, and +, or ordered items between
+	// 1. and 1) forms.
+	FailOnMixedListMarkers *bool `yaml:"fail_on_mixed_list_markers,omitempty" json:"fail_on_mixed_list_markers,omitempty"`
+
+	// FailOnBrokenTables fails files with malformed GFM tables:
+	// missing separator rows or inconsistent column counts.
+	FailOnBrokenTables *bool `yaml:"fail_on_broken_tables,omitempty" json:"fail_on_broken_tables,omitempty"`
+
+	// FailOnBrokenAdmonitions fails files with malformed admonition
+	// blocks or definition lists: under-indented bodies, missing types,
+	// or definitions with no term.
+	FailOnBrokenAdmonitions *bool `yaml:"fail_on_broken_admonitions,omitempty" json:"fail_on_broken_admonitions,omitempty"`
+
+	// FailOnTerminology fails files using disallowed terms from the
+	// terminology rules.
+	FailOnTerminology *bool `yaml:"fail_on_terminology,omitempty" json:"fail_on_terminology,omitempty"`
+
+	// FailOnProseInCode fails files with fenced code blocks that read
+	// like prose, the usual symptom of a missing closing fence.
+	FailOnProseInCode *bool `yaml:"fail_on_prose_in_code,omitempty" json:"fail_on_prose_in_code,omitempty"`
+
+	// FailOnUnlabeledCode fails files with fenced code blocks that
+	// declare no language; unlabeled fences render unhighlighted, and
+	// intentionally-plain blocks should say text.
+	FailOnUnlabeledCode *bool `yaml:"fail_on_unlabeled_code,omitempty" json:"fail_on_unlabeled_code,omitempty"`
+
+	// FailOnBareURLs fails files with raw URLs pasted into prose
+	// instead of wrapped in link syntax.
+	FailOnBareURLs *bool `yaml:"fail_on_bare_urls,omitempty" json:"fail_on_bare_urls,omitempty"`
+
+	// RequireH1 fails files that do not open with exactly one H1
+	// title: missing, duplicated, or preceded by a deeper heading.
+	RequireH1 *bool `yaml:"require_h1,omitempty" json:"require_h1,omitempty"`
+
+	// WarnMargin is the fraction of a readability limit (0.10 for 10%)
+	// within which a passing file reports warn instead of pass. Zero
+	// disables the band; unset uses the 10% default.
+	WarnMargin *float64 `yaml:"warn_margin,omitempty" json:"warn_margin,omitempty"`
+}
+
+// Dump renders the fully resolved configuration as YAML that Load
+// accepts back, for inspecting what defaults, file, and flags actually
+// merged to.
+func (c *Config) Dump() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+// ProfileThresholds resolves a named profile layered over the base
+// thresholds. The second return reports whether the profile exists.
+func (c *Config) ProfileThresholds(name string) (Thresholds, bool) {
+	p, ok := c.Profiles[name]
+	if !ok {
+		return Thresholds{}, false
+	}
+	t := c.Thresholds
+	t.merge(&p)
+	return t, true
+}
+
+// Override adjusts thresholds for files under a path prefix, or excludes
+// them from analysis entirely.
+type Override struct {
+	Path       string      `yaml:"path" json:"path"`
+	Exclude    bool        `yaml:"exclude,omitempty" json:"exclude,omitempty"`
+	Thresholds *Thresholds `yaml:"thresholds,omitempty" json:"thresholds,omitempty"`
+
+	// Profile applies a named profile to files under Path; inline
+	// Thresholds layer on top of it.
+	Profile string `yaml:"profile,omitempty" json:"profile,omitempty"`
+}
+
+// TermRule names a preferred term and the variants the style guide
+// disallows in its favor ("sign-in", not "login"). Matching is
+// word-boundary and case-insensitive unless CaseSensitive is set,
+// which proper-noun rules ("GitHub", not "Github") need.
+type TermRule struct {
+	Preferred     string   `yaml:"preferred" json:"preferred"`
+	Variants      []string `yaml:"variants" json:"variants"`
+	CaseSensitive bool     `yaml:"case_sensitive,omitempty" json:"case_sensitive,omitempty"`
+}
+
+// GradeBand maps Flesch-Kincaid grades up to MaxGrade to a
+// human-readable reading-level label.
+type GradeBand struct {
+	MaxGrade float64 `yaml:"max_grade" json:"max_grade"`
+	Label    string  `yaml:"label" json:"label"`
+}
+
+// Analysis tunes how prose is extracted and measured, as opposed to the
+// limits it is checked against.
+type Analysis struct {
+	// ListItemsAsSentences counts each list item as a sentence even
+	// without terminal punctuation. Without it, list-heavy reference
+	// pages collapse into a few run-on "sentences" and score far worse
+	// than they read.
+	ListItemsAsSentences bool `yaml:"list_items_as_sentences,omitempty" json:"list_items_as_sentences,omitempty"`
+
+	// ExcludeBlockquotes drops blockquote content from the prose the
+	// formulas score. Quotes are included by default — they are text the
+	// reader reads — but teams quoting dense external material (legal
+	// boilerplate, vendor errors) can exclude it rather than let text
+	// they cannot edit tank their scores.
+	ExcludeBlockquotes bool `yaml:"exclude_blockquotes,omitempty" json:"exclude_blockquotes,omitempty"`
+
+	// Language selects the readability formulas and syllable rules:
+	// "es" scores with Fernández-Huerta and Crawford, "de" with
+	// Amstad and the Wiener Sachtextformel. Files override it with a
+	// frontmatter lang key; empty or "en" means English.
+	Language string `yaml:"language,omitempty" json:"language,omitempty"`
+
+	// NormalizeSmartQuotes folds typographic punctuation — curly
+	// quotes, en/em dashes, ellipses, non-breaking and thin spaces —
+	// to ASCII before scoring, so character-based metrics do not
+	// depend on which editor authored the prose. On unless explicitly
+	// set false.
+	NormalizeSmartQuotes *bool `yaml:"normalize_smart_quotes,omitempty" json:"normalize_smart_quotes,omitempty"`
+
+	// SpellingVariant picks the preferred dialect for the spelling
+	// consistency check: "us" or "uk". Empty flags only files that mix
+	// both dialects, whichever they are. See
+	// analyzer.CheckSpellingVariants.
+	SpellingVariant string `yaml:"spelling_variant,omitempty" json:"spelling_variant,omitempty"`
+
+	// StripEmoji removes emoji and :shortcode: sequences before
+	// scoring, so decoration does not move word and character counts.
+	// On unless explicitly set false; see analyzer.StripEmoji for
+	// exactly what is removed.
+	StripEmoji *bool `yaml:"strip_emoji,omitempty" json:"strip_emoji,omitempty"`
+
+	// AbbreviationsFile loads extra sentence-splitting abbreviations
+	// ("approx.", "resp.") from a word list file: one entry per line,
+	// blank lines and #-comments ignored. Periods after listed
+	// abbreviations do not end a sentence.
+	AbbreviationsFile string `yaml:"abbreviations_file,omitempty" json:"abbreviations_file,omitempty"`
+
+	// StopwordsFile loads extra stopwords for duplicate detection from
+	// a word list file, same format as AbbreviationsFile.
+	StopwordsFile string `yaml:"stopwords_file,omitempty" json:"stopwords_file,omitempty"`
+
+	// WordListMode controls whether loaded word lists merge with the
+	// built-in defaults ("merge", the default) or replace them
+	// ("replace"). Replace suits corpora whose defaults misfire, e.g.
+	// non-English docs where the English stopwords are real words.
+	WordListMode string `yaml:"word_list_mode,omitempty" json:"word_list_mode,omitempty"`
+
+	// RepeatedOpeningRun is the number of consecutive same-word
+	// sentence openings that counts as a run; 3 when unset.
+	RepeatedOpeningRun int `yaml:"repeated_opening_run,omitempty" json:"repeated_opening_run,omitempty"`
+
+	// GradeLabels overrides the built-in reading-level bands, checked
+	// in order; grades above every band label as "Professional".
+	GradeLabels []GradeBand `yaml:"grade_labels,omitempty" json:"grade_labels,omitempty"`
+
+	// SyllableOverrides corrects the heuristic syllable count for
+	// specific words ("kubernetes: 4"), keyed on the lowercase word.
+	// Technical terms the estimator mis-counts skew FK and SMOG; use
+	// the syllables subcommand to find candidates.
+	SyllableOverrides map[string]int `yaml:"syllable_overrides,omitempty" json:"syllable_overrides,omitempty"`
+
+	// ReadingRates tunes the reading-time estimate's per-element
+	// speeds; zero fields keep the analyzer's defaults. See
+	// analyzer.ReadingTimeMinutes.
+	ReadingRates ReadingRates `yaml:"reading_rates,omitempty" json:"reading_rates,omitempty"`
+}
+
+// ReadingRates are the per-element speeds behind the reading-time
+// estimate: prose read at a words-per-minute pace, code stepped through
+// line by line at its own pace, and a fixed scanning cost per heading.
+type ReadingRates struct {
+	WordsPerMinute     float64 `yaml:"words_per_minute,omitempty" json:"words_per_minute,omitempty"`
+	CodeLinesPerMinute float64 `yaml:"code_lines_per_minute,omitempty" json:"code_lines_per_minute,omitempty"`
+	SecondsPerHeading  float64 `yaml:"seconds_per_heading,omitempty" json:"seconds_per_heading,omitempty"`
+}
+
+// Severity levels a check's failures can carry. Only error-level
+// failures make a file fail; warnings and info stay visible in output
+// without blocking CI.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+)
+
+// OversizeAction values; see Config.OversizeAction.
+const (
+	OversizeSkip = "skip"
+	OversizeFail = "fail"
+)
+
+// WordListMode values; see Analysis.WordListMode.
+const (
+	WordListMerge   = "merge"
+	WordListReplace = "replace"
+)
+
+// Config is the full analyzer configuration.
+type Config struct {
+	Thresholds Thresholds `yaml:"thresholds" json:"thresholds"`
+	Analysis   Analysis   `yaml:"analysis,omitempty" json:"analysis,omitempty"`
+	Overrides  []Override `yaml:"overrides,omitempty" json:"overrides,omitempty"`
+
+	// SkipFilenames lists base names to skip wherever they appear,
+	// matched case-insensitively against the file name alone. It covers
+	// the "ignore this boilerplate everywhere" case without a glob per
+	// directory; overrides with exclude remain the tool for skipping
+	// whole paths. Defaults to CHANGELOG.md and CONTRIBUTING.md; set
+	// skip_filenames: [] to clear it.
+	SkipFilenames []string `yaml:"skip_filenames,omitempty" json:"skip_filenames,omitempty"`
+
+	// Severities maps a check name ("max_grade") to error, warning, or
+	// info. Unlisted checks are errors.
+	Severities map[string]string `yaml:"severities,omitempty" json:"severities,omitempty"`
+
+	// MessageTemplate customizes failure messages, e.g. to link an
+	// internal style guide. It is a Go text/template with access to
+	// .File, .Check, .Value, .Limit, and .Message (the default text).
+	// Empty keeps the default messages.
+	MessageTemplate string `yaml:"message_template,omitempty" json:"message_template,omitempty"`
+
+	// MaxFileBytes guards against runaway generated files: anything
+	// larger is never read or parsed. Zero disables the guard.
+	MaxFileBytes int64 `yaml:"max_file_bytes,omitempty" json:"max_file_bytes,omitempty"`
+
+	// OversizeAction decides what becomes of files over MaxFileBytes:
+	// "skip" (the default) reports them skipped with the size as the
+	// reason, "fail" fails them.
+	OversizeAction string `yaml:"oversize_action,omitempty" json:"oversize_action,omitempty"`
+
+	// Terminology lists the preferred-term rules the terminology check
+	// enforces; empty disables the check.
+	Terminology []TermRule `yaml:"terminology,omitempty" json:"terminology,omitempty"`
+
+	// Profiles names threshold sets for content types ("reference",
+	// "tutorial", "blog") that layer over the base thresholds. Files
+	// select one via frontmatter content-type or an override's
+	// profile field, so interleaved content types need no directory
+	// split.
+	Profiles map[string]Thresholds `yaml:"profiles,omitempty" json:"profiles,omitempty"`
+}
+
+// SeverityFor returns the configured severity for a check, SeverityError
+// when the check is not listed.
+func (c *Config) SeverityFor(check string) string {
+	if s, ok := c.Severities[check]; ok {
+		return s
+	}
+	return SeverityError
+}
+
+// Default returns the configuration used when no file is present:
+// college-senior readability, the repository's standard line cap, and
+// the code-heavy-page word floor.
+func Default() *Config {
+	return &Config{
+		Thresholds: Thresholds{
+			MaxGrade: f(16),
+			MaxARI:   f(16),
+			MaxFog:   f(18),
+			MinEase:  f(25),
+			MaxLines: i(375),
+			MinWords: i(200),
+		},
+		SkipFilenames: []string{"CHANGELOG.md", "CONTRIBUTING.md"},
+	}
+}
+
+// SkipFilename reports whether path's base name is on the
+// SkipFilenames list.
+func (c *Config) SkipFilename(path string) bool {
+	base := filepath.Base(path)
+	for _, name := range c.SkipFilenames {
+		if strings.EqualFold(base, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Load reads the configuration at path. When path is DefaultPath and the
+// file does not exist, Default is returned instead of an error.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && path == DefaultPath {
+			return Default(), nil
+		}
+		return nil, fmt.Errorf("load config: %w", err)
+	}
+	cfg := Default()
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("load config %s: %w", path, err)
+	}
+	for check, sev := range cfg.Severities {
+		switch sev {
+		case SeverityError, SeverityWarning, SeverityInfo:
+		default:
+			return nil, fmt.Errorf("load config %s: severity for %s is %q, want error, warning, or info", path, check, sev)
+		}
+	}
+	if cfg.MessageTemplate != "" {
+		if _, err := template.New("message").Parse(cfg.MessageTemplate); err != nil {
+			return nil, fmt.Errorf("load config %s: message_template: %w", path, err)
+		}
+	}
+	switch cfg.OversizeAction {
+	case "", OversizeSkip, OversizeFail:
+	default:
+		return nil, fmt.Errorf("load config %s: oversize_action is %q, want skip or fail", path, cfg.OversizeAction)
+	}
+	for _, r := range cfg.Terminology {
+		if r.Preferred == "" || len(r.Variants) == 0 {
+			return nil, fmt.Errorf("load config %s: terminology rules need a preferred term and at least one variant", path)
+		}
+	}
+	switch cfg.Analysis.WordListMode {
+	case "", WordListMerge, WordListReplace:
+	default:
+		return nil, fmt.Errorf("load config %s: word_list_mode is %q, want merge or replace", path, cfg.Analysis.WordListMode)
+	}
+	for _, o := range cfg.Overrides {
+		if o.Profile == "" {
+			continue
+		}
+		if _, ok := cfg.Profiles[o.Profile]; !ok {
+			return nil, fmt.Errorf("load config %s: override %s references unknown profile %q", path, o.Path, o.Profile)
+		}
+	}
+	return cfg, nil
+}
+
+// ForPath resolves the effective thresholds for a file. Overrides are
+// applied in order, each matching prefix layering its set fields over the
+// base. The second return reports whether the file is excluded.
+func (c *Config) ForPath(path string) (Thresholds, bool) {
+	t, _, excluded := c.ForPathProfile(path)
+	return t, excluded
+}
+
+// ForPathProfile is ForPath also reporting the name of the last profile
+// an override applied, empty when none did. An override's profile
+// layers over the thresholds accumulated so far, and its inline
+// thresholds layer over the profile.
+func (c *Config) ForPathProfile(path string) (Thresholds, string, bool) {
+	t := c.Thresholds
+	profile := ""
+	excluded := false
+	norm := filepath.ToSlash(path)
+	for _, o := range c.Overrides {
+		if !matchPrefix(norm, filepath.ToSlash(o.Path)) {
+			continue
+		}
+		excluded = o.Exclude
+		if o.Profile != "" {
+			if p, ok := c.Profiles[o.Profile]; ok {
+				t.merge(&p)
+				profile = o.Profile
+			}
+		}
+		if o.Thresholds != nil {
+			t.merge(o.Thresholds)
+		}
+	}
+	return t, profile, excluded
+}
+
+func matchPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+func (t *Thresholds) merge(o *Thresholds) {
+	if o.MaxGrade != nil {
+		t.MaxGrade = o.MaxGrade
+	}
+	if o.MaxARI != nil {
+		t.MaxARI = o.MaxARI
+	}
+	if o.MaxFog != nil {
+		t.MaxFog = o.MaxFog
+	}
+	if o.MinEase != nil {
+		t.MinEase = o.MinEase
+	}
+	if o.MaxColemanLiau != nil {
+		t.MaxColemanLiau = o.MaxColemanLiau
+	}
+	if o.MaxSMOG != nil {
+		t.MaxSMOG = o.MaxSMOG
+	}
+	if o.MaxLines != nil {
+		t.MaxLines = o.MaxLines
+	}
+	if o.MaxScrollWeight != nil {
+		t.MaxScrollWeight = o.MaxScrollWeight
+	}
+	if o.MinWords != nil {
+		t.MinWords = o.MinWords
+	}
+	if o.MaxListDepth != nil {
+		t.MaxListDepth = o.MaxListDepth
+	}
+	if o.MinHeadingDensity != nil {
+		t.MinHeadingDensity = o.MinHeadingDensity
+	}
+	if o.MaxCodeRatio != nil {
+		t.MaxCodeRatio = o.MaxCodeRatio
+	}
+	if o.MinProseWords != nil {
+		t.MinProseWords = o.MinProseWords
+	}
+	if o.MaxSentenceWords != nil {
+		t.MaxSentenceWords = o.MaxSentenceWords
+	}
+	if o.MaxFirstParagraphGrade != nil {
+		t.MaxFirstParagraphGrade = o.MaxFirstParagraphGrade
+	}
+	if o.MaxMetricSpread != nil {
+		t.MaxMetricSpread = o.MaxMetricSpread
+	}
+	if o.MaxHeadingWords != nil {
+		t.MaxHeadingWords = o.MaxHeadingWords
+	}
+	if o.MaxConsecutiveCodeBlocks != nil {
+		t.MaxConsecutiveCodeBlocks = o.MaxConsecutiveCodeBlocks
+	}
+	if o.FailOnSpelling != nil {
+		t.FailOnSpelling = o.FailOnSpelling
+	}
+	if o.FailOnSpellingVariants != nil {
+		t.FailOnSpellingVariants = o.FailOnSpellingVariants
+	}
+	if o.FailOnWhitespace != nil {
+		t.FailOnWhitespace = o.FailOnWhitespace
+	}
+	if o.FailOnMarkdownSyntax != nil {
+		t.FailOnMarkdownSyntax = o.FailOnMarkdownSyntax
+	}
+	if o.FailOnEmptySections != nil {
+		t.FailOnEmptySections = o.FailOnEmptySections
+	}
+	if o.FailOnRepeatedOpenings != nil {
+		t.FailOnRepeatedOpenings = o.FailOnRepeatedOpenings
+	}
+	if o.FailOnHeadingStyle != nil {
+		t.FailOnHeadingStyle = o.FailOnHeadingStyle
+	}
+	if o.FailOnBrokenTables != nil {
+		t.FailOnBrokenTables = o.FailOnBrokenTables
+	}
+	if o.FailOnBrokenAdmonitions != nil {
+		t.FailOnBrokenAdmonitions = o.FailOnBrokenAdmonitions
+	}
+	if o.FailOnTerminology != nil {
+		t.FailOnTerminology = o.FailOnTerminology
+	}
+	if o.FailOnProseInCode != nil {
+		t.FailOnProseInCode = o.FailOnProseInCode
+	}
+	if o.FailOnUnlabeledCode != nil {
+		t.FailOnUnlabeledCode = o.FailOnUnlabeledCode
+	}
+	if o.FailOnBareURLs != nil {
+		t.FailOnBareURLs = o.FailOnBareURLs
+	}
+	if o.FailOnMixedListMarkers != nil {
+		t.FailOnMixedListMarkers = o.FailOnMixedListMarkers
+	}
+	if o.RequireH1 != nil {
+		t.RequireH1 = o.RequireH1
+	}
+	if o.WarnMargin != nil {
+		t.WarnMargin = o.WarnMargin
+	}
+}
+
+// StructuralOnly returns a copy with the readability-formula limits
+// cleared, leaving only the thresholds structural analysis can judge:
+// sizes, ratios, densities, and the fail_on_* checks. It backs the
+// CLI's --structural-only mode, where the formula metrics are never
+// computed and would otherwise all read as zero.
+func (t Thresholds) StructuralOnly() Thresholds {
+	t.MaxGrade = nil
+	t.MaxARI = nil
+	t.MaxFog = nil
+	t.MinEase = nil
+	t.MaxColemanLiau = nil
+	t.MaxSMOG = nil
+	t.MaxSentenceWords = nil
+	t.MaxMetricSpread = nil
+	t.MaxFirstParagraphGrade = nil
+	return t
+}
+
+// Set assigns a threshold by its config name ("max_grade"; hyphens are
+// accepted for flag spelling). Integer thresholds truncate the value.
+func (t *Thresholds) Set(name string, value float64) error {
+	switch strings.ReplaceAll(name, "-", "_") {
+	case "max_grade":
+		t.MaxGrade = f(value)
+	case "max_ari":
+		t.MaxARI = f(value)
+	case "max_fog":
+		t.MaxFog = f(value)
+	case "min_ease":
+		t.MinEase = f(value)
+	case "max_coleman_liau":
+		t.MaxColemanLiau = f(value)
+	case "max_smog":
+		t.MaxSMOG = f(value)
+	case "max_lines":
+		t.MaxLines = i(int(value))
+	case "max_scroll_weight":
+		t.MaxScrollWeight = i(int(value))
+	case "min_words":
+		t.MinWords = i(int(value))
+	case "max_list_depth":
+		t.MaxListDepth = i(int(value))
+	case "min_heading_density":
+		t.MinHeadingDensity = i(int(value))
+	case "max_code_ratio":
+		t.MaxCodeRatio = f(value)
+	case "min_prose_words":
+		t.MinProseWords = i(int(value))
+	case "max_sentence_words":
+		t.MaxSentenceWords = i(int(value))
+	case "max_heading_words":
+		t.MaxHeadingWords = i(int(value))
+	case "max_metric_spread":
+		t.MaxMetricSpread = f(value)
+	case "max_first_paragraph_grade":
+		t.MaxFirstParagraphGrade = f(value)
+	case "max_consecutive_code_blocks":
+		t.MaxConsecutiveCodeBlocks = i(int(value))
+	case "warn_margin":
+		t.WarnMargin = f(value)
+	default:
+		return fmt.Errorf("unknown threshold %q", name)
+	}
+	return nil
+}
+
+func f(v float64) *float64 { return &v }
+func i(v int) *int         { return &v }
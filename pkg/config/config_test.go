@@ -0,0 +1,73 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDumpRoundTrips(t *testing.T) {
+	cfg := Default()
+	cfg.Severities = map[string]string{"max_lines": SeverityWarning}
+	cfg.Profiles = map[string]Thresholds{"blog": {MaxLines: i(50)}}
+	data, err := cfg.Dump()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "dumped.yml")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatalf("dumped config did not load back: %v", err)
+	}
+	if *got.Thresholds.MaxGrade != *cfg.Thresholds.MaxGrade {
+		t.Errorf("max_grade = %v, want %v", *got.Thresholds.MaxGrade, *cfg.Thresholds.MaxGrade)
+	}
+	if *got.Profiles["blog"].MaxLines != 50 {
+		t.Errorf("profile did not survive the round trip: %+v", got.Profiles)
+	}
+}
+
+func TestApplyEnviron(t *testing.T) {
+	cfg := Default()
+	env := []string{
+		"CONTENT_ANALYZER_MAX_GRADE=7.5",
+		"CONTENT_ANALYZER_MAX_LINES=120",
+		"PATH=/usr/bin",
+	}
+	if err := cfg.applyEnviron(env); err != nil {
+		t.Fatal(err)
+	}
+	if *cfg.Thresholds.MaxGrade != 7.5 {
+		t.Errorf("MaxGrade = %v, want 7.5", *cfg.Thresholds.MaxGrade)
+	}
+	if *cfg.Thresholds.MaxLines != 120 {
+		t.Errorf("MaxLines = %v, want 120", *cfg.Thresholds.MaxLines)
+	}
+
+	if err := cfg.applyEnviron([]string{"CONTENT_ANALYZER_MAX_TYPO=1"}); err == nil {
+		t.Error("unknown threshold variable did not error")
+	}
+	if err := cfg.applyEnviron([]string{"CONTENT_ANALYZER_MAX_GRADE=high"}); err == nil {
+		t.Error("non-numeric value did not error")
+	}
+}
+
+func TestSkipFilename(t *testing.T) {
+	cfg := Default()
+	if !cfg.SkipFilename("docs/deep/CHANGELOG.md") {
+		t.Error("default CHANGELOG.md not skipped")
+	}
+	if !cfg.SkipFilename("changelog.md") {
+		t.Error("case-insensitive match failed")
+	}
+	if cfg.SkipFilename("docs/guide.md") {
+		t.Error("guide.md skipped unexpectedly")
+	}
+	cfg.SkipFilenames = []string{}
+	if cfg.SkipFilename("CHANGELOG.md") {
+		t.Error("cleared list still skips")
+	}
+}
@@ -0,0 +1,47 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// EnvPrefix marks threshold overrides in the environment. A variable
+// like CONTENT_ANALYZER_MAX_GRADE=10 sets the max_grade threshold: the
+// suffix lowercased is the config name, the value its number. Every
+// name Thresholds.Set accepts works, so containerized CI can tune
+// thresholds without templating config files or stacking flags.
+//
+// Precedence is defaults, then the config file, then the environment,
+// then flags: ApplyEnv runs after Load and the CLI applies flag
+// overrides after it.
+const EnvPrefix = "CONTENT_ANALYZER_"
+
+// ApplyEnv overlays EnvPrefix threshold overrides from the process
+// environment onto c. A prefixed variable whose suffix is not a known
+// threshold, or whose value does not parse as a number, is an error:
+// a typo silently enforcing nothing would defeat the gate.
+func (c *Config) ApplyEnv() error {
+	return c.applyEnviron(os.Environ())
+}
+
+// applyEnviron is ApplyEnv over an explicit environment, split out for
+// tests.
+func (c *Config) applyEnviron(environ []string) error {
+	for _, kv := range environ {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(key, EnvPrefix) {
+			continue
+		}
+		name := strings.ToLower(strings.TrimPrefix(key, EnvPrefix))
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%s: value %q is not a number", key, value)
+		}
+		if err := c.Thresholds.Set(name, num); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package config
+
+// Sample is the commented configuration the init subcommand scaffolds.
+// Every Thresholds field appears here, active ones at their Default
+// values and optional checks commented out; TestSampleCoversThresholds
+// keeps it honest as the struct grows.
+const Sample = `# Readability thresholds enforced by the readability CLI.
+# Every limit is optional: delete or comment a line to disable that
+# check. Values below are the built-in defaults.
+thresholds:
+  # Maximum Flesch-Kincaid grade level.
+  max_grade: 16
+  # Maximum Automated Readability Index.
+  max_ari: 16
+  # Maximum Gunning fog index.
+  max_fog: 18
+  # Minimum Flesch reading ease (higher is easier).
+  min_ease: 25
+  # Maximum Coleman-Liau index.
+  # max_coleman_liau: 16
+  # Maximum SMOG grade.
+  # max_smog: 18
+  # Maximum lines per file.
+  max_lines: 375
+  # Files with fewer words than this are skipped, not failed.
+  min_words: 200
+  # Maximum estimated rendered length in line-equivalents; images and
+  # code-block chrome count beyond raw lines.
+  # max_scroll_weight: 500
+  # Maximum bullet/numbered list nesting depth.
+  # max_list_depth: 3
+  # Require at least one H2 per this many lines in long files.
+  # min_heading_density: 100
+  # Maximum share of lines inside code blocks, 0-1.
+  # max_code_ratio: 0.6
+  # Minimum prose words; unlike min_words this fails rather than skips.
+  # min_prose_words: 50
+  # Fail files containing any single sentence over this many words.
+  # max_sentence_words: 40
+  # Fail files with headings over this many words.
+  # max_heading_words: 10
+  # Fail files whose grade-level formulas disagree by more than this many grades.
+  # max_metric_spread: 8
+  # Fail files whose opening paragraph scores above this grade; usually stricter than max_grade.
+  # max_first_paragraph_grade: 9
+  # Maximum fenced code blocks in a row with no prose between them.
+  # max_consecutive_code_blocks: 2
+  # Fail files with unknown words when --spell is on.
+  # fail_on_spelling: true
+  # Fail files with inconsistent US/UK spellings; see
+  # analysis.spelling_variant for picking a preferred dialect.
+  # fail_on_spelling_variants: true
+  # Fail files with whitespace issues when --whitespace is on.
+  # fail_on_whitespace: true
+  # Fail files with empty sections or nothing but headings.
+  # fail_on_empty_sections: true
+  # Fail files where consecutive sentences open with the same word.
+  # fail_on_repeated_openings: true
+  # Fail files with setext (underlined) or mixed heading styles.
+  # fail_on_heading_style: true
+  # Fail files with malformed tables (missing separator, ragged columns).
+  # fail_on_broken_tables: true
+  # Fail files with malformed admonitions or definition lists.
+  # fail_on_broken_admonitions: true
+  # Fail files using disallowed terms from the terminology rules.
+  # fail_on_terminology: true
+  # Fail files with code blocks that read like prose (missing closing fence).
+  # fail_on_prose_in_code: true
+  # Fail files mixing list marker styles (-, *, + or 1. vs 1)).
+  # fail_on_mixed_list_markers: true
+  # Fail files with malformed markdown when --strict-markdown runs.
+  # fail_on_markdown_syntax: true
+  # Fail files with code fences that declare no language.
+  # fail_on_unlabeled_code: true
+  # Fail files with raw URLs in prose instead of link syntax.
+  # fail_on_bare_urls: true
+  # Fail files that do not open with exactly one H1 title.
+  # require_h1: true
+  # Report warn for passing files within this fraction of a limit.
+  # warn_margin: 0.10
+
+# Never read files larger than this many bytes; a runaway generated
+# file should not hang the run. oversize_action picks skip or fail.
+# max_file_bytes: 5242880
+# oversize_action: skip
+
+# Customize failure messages (Go text/template; .File, .Check, .Value,
+# .Limit, .Message). Useful for linking your style guide.
+# message_template: "{{ .Message }} - see https://wiki.example.com/style-guide#readability"
+
+# Tune how prose is measured, as opposed to the limits above.
+# analysis:
+#   list_items_as_sentences: true
+#   # Drop blockquote content from scoring; quoted text counts by
+#   # default, but dense external quotes can be excluded.
+#   exclude_blockquotes: true
+#   # Score with another language's formulas: es or de.
+#   language: es
+#   # Preferred dialect for fail_on_spelling_variants: us or uk.
+#   # Unset flags only files mixing both.
+#   spelling_variant: us
+#   repeated_opening_run: 3
+#   # Fold typographic punctuation to ASCII before scoring: curly
+#   # quotes, en/em dashes, ellipses, non-breaking and thin spaces.
+#   normalize_smart_quotes: true
+#   # Emoji and :shortcodes: are stripped before scoring so decoration
+#   # does not move the counts; set false to score them as content.
+#   strip_emoji: false
+#   # Word list files: one entry per line, # comments allowed. Entries
+#   # merge with the built-in defaults unless word_list_mode is replace.
+#   abbreviations_file: abbreviations.txt
+#   stopwords_file: stopwords.txt
+#   word_list_mode: merge
+#   syllable_overrides:
+#     kubernetes: 4
+#   # Reading-time estimate speeds; omitted fields keep the defaults
+#   # (200 wpm prose, 50 code lines/min, 2s of scanning per heading).
+#   reading_rates:
+#     words_per_minute: 200
+#     code_lines_per_minute: 50
+#     seconds_per_heading: 2
+
+# Enforce preferred terms; matching is word-boundary and
+# case-insensitive unless a rule sets case_sensitive for proper nouns.
+# terminology:
+#   - preferred: sign-in
+#     variants: [login, log-in]
+#   - preferred: GitHub
+#     variants: [Github]
+#     case_sensitive: true
+
+# Name threshold sets for content types; files select one via
+# frontmatter content-type or an override's profile field.
+# profiles:
+#   reference:
+#     max_grade: 18
+#     max_lines: 600
+#   blog:
+#     max_grade: 12
+
+# Skip these base names wherever they appear, matched
+# case-insensitively against the file name alone. Handy for boilerplate
+# that lives in every directory; path-prefix excludes below handle
+# whole trees. Set skip_filenames: [] to analyze everything.
+# skip_filenames:
+#   - CHANGELOG.md
+#   - CONTRIBUTING.md
+
+# Adjust or exclude files under a path prefix.
+# overrides:
+#   - path: docs/reference
+#     profile: reference
+#   - path: docs/guides
+#     thresholds:
+#       max_grade: 18
+#   - path: docs/generated
+#     exclude: true
+
+# Downgrade a check so it reports without failing the run.
+# severities:
+#   max_lines: warning
+`
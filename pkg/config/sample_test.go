@@ -0,0 +1,39 @@
+package config
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TestSampleCoversThresholds fails when a Thresholds field is added
+// without documenting it in Sample, so the scaffolded config stays a
+// complete map of the configuration surface.
+func TestSampleCoversThresholds(t *testing.T) {
+	rt := reflect.TypeOf(Thresholds{})
+	for i := 0; i < rt.NumField(); i++ {
+		tag := rt.Field(i).Tag.Get("yaml")
+		name := strings.Split(tag, ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		if !strings.Contains(Sample, name+":") {
+			t.Errorf("Sample does not mention threshold %q", name)
+		}
+	}
+}
+
+// TestSampleParsesToDefaults checks the uncommented values in Sample
+// are exactly the built-in defaults, so init scaffolds what users
+// already get implicitly.
+func TestSampleParsesToDefaults(t *testing.T) {
+	var cfg Config
+	if err := yaml.Unmarshal([]byte(Sample), &cfg); err != nil {
+		t.Fatalf("Sample does not parse: %v", err)
+	}
+	if !reflect.DeepEqual(cfg.Thresholds, Default().Thresholds) {
+		t.Errorf("Sample thresholds = %+v, want defaults %+v", cfg.Thresholds, Default().Thresholds)
+	}
+}
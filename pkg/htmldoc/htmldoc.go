@@ -0,0 +1,123 @@
+// Package htmldoc extracts visible text and headings from hand-written
+// HTML pages so the readability pipeline can gate them alongside
+// markdown. Scripts, styles, and markup are stripped; <h1>-<h6> become
+// headings, <li> become list items, and <pre> content counts as code
+// lines rather than prose.
+package htmldoc
+
+import (
+	"bytes"
+	"strings"
+
+	"golang.org/x/net/html"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+// Document is the parsed representation of an HTML file, shaped like
+// markdown.Document where the analyzer needs it.
+type Document struct {
+	Path   string
+	Source []byte
+
+	blocks    []markdown.Block
+	headings  []markdown.Heading
+	codeLines int
+}
+
+// headingLevels maps heading elements to their outline level.
+var headingLevels = map[string]int{
+	"h1": 1, "h2": 2, "h3": 3, "h4": 4, "h5": 5, "h6": 6,
+}
+
+// Parse extracts blocks and headings from src.
+func Parse(path string, src []byte) (*Document, error) {
+	root, err := html.Parse(bytes.NewReader(src))
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Document{Path: path, Source: src}
+	slugger := markdown.NewSlugger()
+
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch {
+			case n.Data == "script" || n.Data == "style" || n.Data == "noscript":
+				return
+			case n.Data == "pre":
+				if text := strings.TrimSpace(rawText(n)); text != "" {
+					d.codeLines += strings.Count(text, "\n") + 1
+				}
+				return
+			case headingLevels[n.Data] != 0:
+				if text := nodeText(n); text != "" {
+					d.headings = append(d.headings, markdown.Heading{
+						Level: headingLevels[n.Data],
+						Text:  text,
+						Slug:  slugger.Slug(text),
+					})
+					d.blocks = append(d.blocks, markdown.Block{Kind: markdown.BlockHeading, Text: text})
+				}
+				return
+			case n.Data == "p":
+				if text := nodeText(n); text != "" {
+					d.blocks = append(d.blocks, markdown.Block{Kind: markdown.BlockParagraph, Text: text})
+				}
+				return
+			case n.Data == "li":
+				if text := nodeText(n); text != "" {
+					d.blocks = append(d.blocks, markdown.Block{Kind: markdown.BlockListItem, Text: text})
+				}
+				return
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(root)
+	return d, nil
+}
+
+// nodeText collects the visible text beneath n, skipping script and
+// style subtrees and collapsing whitespace.
+func nodeText(n *html.Node) string {
+	return strings.Join(strings.Fields(rawText(n)), " ")
+}
+
+// rawText concatenates the text nodes beneath n verbatim, skipping
+// script and style subtrees; <pre> line counting needs the newlines
+// nodeText collapses away.
+func rawText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+			return
+		}
+		if n.Type == html.ElementNode &&
+			(n.Data == "script" || n.Data == "style" || n.Data == "noscript") {
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+// Bytes returns the raw file content.
+func (d *Document) Bytes() []byte { return d.Source }
+
+// Blocks returns the document's prose blocks in order.
+func (d *Document) Blocks() []markdown.Block { return d.blocks }
+
+// Headings returns the document's headings in order.
+func (d *Document) Headings() []markdown.Heading { return d.headings }
+
+// CodeLines counts the lines inside <pre> blocks.
+func (d *Document) CodeLines() int { return d.codeLines }
@@ -0,0 +1,70 @@
+package htmldoc
+
+import (
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+const sample = `<!DOCTYPE html>
+<html>
+<head>
+  <title>Ignored</title>
+  <style>body { color: red; }</style>
+</head>
+<body>
+  <h1>Page Title</h1>
+  <p>Intro paragraph with <a href="#">a link</a> and <code>inline code</code>.</p>
+  <script>console.log("hidden");</script>
+  <h2>Section One</h2>
+  <pre>line one
+line two</pre>
+  <ul>
+    <li>first item</li>
+    <li>second item</li>
+  </ul>
+</body>
+</html>`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse("sample.html", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs := doc.Headings()
+	if len(hs) != 2 {
+		t.Fatalf("got %d headings, want 2: %+v", len(hs), hs)
+	}
+	if hs[0].Level != 1 || hs[0].Text != "Page Title" || hs[0].Slug != "page-title" {
+		t.Errorf("heading 0 = %+v", hs[0])
+	}
+	if hs[1].Level != 2 || hs[1].Text != "Section One" {
+		t.Errorf("heading 1 = %+v", hs[1])
+	}
+
+	if doc.CodeLines() != 2 {
+		t.Errorf("CodeLines = %d, want 2", doc.CodeLines())
+	}
+
+	var para, items int
+	for _, b := range doc.Blocks() {
+		switch b.Kind {
+		case markdown.BlockParagraph:
+			para++
+			if b.Text != "Intro paragraph with a link and inline code." {
+				t.Errorf("paragraph = %q", b.Text)
+			}
+		case markdown.BlockListItem:
+			items++
+		}
+	}
+	if para != 1 || items != 2 {
+		t.Errorf("got %d paragraphs and %d list items, want 1 and 2", para, items)
+	}
+	for _, b := range doc.Blocks() {
+		if b.Text == `console.log("hidden");` {
+			t.Error("script content leaked into prose")
+		}
+	}
+}
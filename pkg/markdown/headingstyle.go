@@ -0,0 +1,92 @@
+package markdown
+
+import "strings"
+
+// Heading style markers; see Document.HeadingStyles.
+const (
+	StyleATX    = "atx"
+	StyleSetext = "setext"
+)
+
+// HeadingStyle records how one heading was written in the source, which
+// the AST does not preserve.
+type HeadingStyle struct {
+	// Line is the 1-based line of the heading text.
+	Line int
+
+	// Level is the heading depth: setext supports 1 and 2 only.
+	Level int
+
+	// Style is StyleATX or StyleSetext.
+	Style string
+}
+
+// HeadingStyles scans the source for each heading's written style:
+// ATX (`## Title`) or setext (a title underlined with = or -). Fenced
+// code and frontmatter are skipped. Line numbers refer to the heading
+// text, not a setext underline.
+func (d *Document) HeadingStyles() []HeadingStyle {
+	var out []HeadingStyle
+	lines := strings.Split(string(d.Source), "\n")
+	inFence := false
+	inFrontmatter := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if i == 0 && trimmed == "---" {
+			inFrontmatter = true
+			continue
+		}
+		if inFrontmatter {
+			if trimmed == "---" {
+				inFrontmatter = false
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+		if level := atxLevel(trimmed); level > 0 {
+			out = append(out, HeadingStyle{Line: i + 1, Level: level, Style: StyleATX})
+			continue
+		}
+		if level := setextLevel(trimmed); level > 0 && i > 0 {
+			prev := strings.TrimSpace(lines[i-1])
+			if prev != "" && atxLevel(prev) == 0 && !strings.HasPrefix(prev, "- ") {
+				out = append(out, HeadingStyle{Line: i, Level: level, Style: StyleSetext})
+			}
+		}
+	}
+	return out
+}
+
+// atxLevel returns the heading level of an ATX heading line, 0 for
+// non-headings.
+func atxLevel(line string) int {
+	n := 0
+	for n < len(line) && line[n] == '#' {
+		n++
+	}
+	if n == 0 || n > 6 || n == len(line) || line[n] != ' ' {
+		return 0
+	}
+	return n
+}
+
+// setextLevel classifies a setext underline: = is level 1, - level 2.
+// The caller checks that a title line precedes it.
+func setextLevel(line string) int {
+	if line == "" {
+		return 0
+	}
+	switch {
+	case strings.Trim(line, "=") == "":
+		return 1
+	case strings.Trim(line, "-") == "":
+		return 2
+	}
+	return 0
+}
@@ -0,0 +1,263 @@
+// Package markdown parses markdown sources and extracts the structural
+// pieces the analyzer cares about: prose, headings, and code blocks.
+package markdown
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gomarkdown/markdown"
+	"github.com/gomarkdown/markdown/ast"
+	"github.com/gomarkdown/markdown/parser"
+	"gopkg.in/yaml.v3"
+)
+
+// Document is the parsed representation of a single markdown file.
+type Document struct {
+	// Path is the file path the document was read from, as given.
+	Path string
+
+	// Source is the raw file content, including any frontmatter.
+	Source []byte
+
+	// Frontmatter holds the parsed YAML frontmatter, or nil if the file
+	// has none.
+	Frontmatter map[string]any
+
+	// FrontmatterUnclosed marks a file that opens with --- but never
+	// closes the block. The content parses as body so nothing is
+	// swallowed, but the stray delimiter is worth surfacing.
+	FrontmatterUnclosed bool
+
+	// AST is the parsed markdown tree, frontmatter excluded.
+	AST ast.Node
+}
+
+// Heading is a single heading extracted from a document.
+type Heading struct {
+	// Level is the heading depth, 1 for H1 through 6 for H6.
+	Level int
+
+	// Text is the rendered heading text with inline markup stripped.
+	Text string
+
+	// Slug is the anchor the site generator derives from Text, unique
+	// within the document.
+	Slug string
+}
+
+// NormalizeNewlines folds CRLF line endings to LF. Everything
+// downstream splits and counts on \n; without this pass a stray \r
+// rides along on every line of a Windows-authored file, skewing
+// line-based checks and leaving carriage returns in extracted prose.
+func NormalizeNewlines(src []byte) []byte {
+	if !bytes.Contains(src, []byte("\r\n")) {
+		return src
+	}
+	return bytes.ReplaceAll(src, []byte("\r\n"), []byte("\n"))
+}
+
+// Parse parses src as markdown. CRLF line endings are normalized to LF
+// and YAML frontmatter delimited by `---` lines is stripped before
+// parsing and decoded into Frontmatter.
+func Parse(path string, src []byte) (*Document, error) {
+	src = NormalizeNewlines(src)
+	doc := &Document{Path: path, Source: src}
+
+	body, fm, unclosed, err := splitFrontmatter(src)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	doc.Frontmatter = fm
+	doc.FrontmatterUnclosed = unclosed
+
+	p := parser.NewWithExtensions(parser.CommonExtensions)
+	doc.AST = markdown.Parse(body, p)
+	return doc, nil
+}
+
+// ParseKeepFrontmatter parses src without stripping a leading
+// frontmatter block, for files where that block is user-facing content
+// that should count toward the metrics.
+func ParseKeepFrontmatter(path string, src []byte) (*Document, error) {
+	src = NormalizeNewlines(src)
+	doc := &Document{Path: path, Source: src}
+	p := parser.NewWithExtensions(parser.CommonExtensions)
+	doc.AST = markdown.Parse(src, p)
+	return doc, nil
+}
+
+// splitFrontmatter separates a leading YAML frontmatter block from the
+// markdown body. It returns the body, the decoded frontmatter (nil when
+// absent), whether an opened block was never closed, and any YAML
+// decode error. An unclosed block is left in the body so the file's
+// content is not silently swallowed as frontmatter.
+func splitFrontmatter(src []byte) ([]byte, map[string]any, bool, error) {
+	delim := []byte("---")
+	if !bytes.HasPrefix(src, delim) {
+		return src, nil, false, nil
+	}
+	rest := src[len(delim):]
+	if len(rest) > 0 && rest[0] != '\n' && !bytes.HasPrefix(rest, []byte("\r\n")) {
+		return src, nil, false, nil
+	}
+	end := bytes.Index(rest, []byte("\n---"))
+	if end < 0 {
+		return src, nil, true, nil
+	}
+	block := rest[:end]
+	body := rest[end+len("\n---"):]
+	if i := bytes.IndexByte(body, '\n'); i >= 0 {
+		body = body[i+1:]
+	} else {
+		body = nil
+	}
+
+	var fm map[string]any
+	if err := yaml.Unmarshal(block, &fm); err != nil {
+		return nil, nil, false, fmt.Errorf("frontmatter: %w", err)
+	}
+	return body, fm, false, nil
+}
+
+// Meta returns the parsed frontmatter, nil when the file has none.
+func (d *Document) Meta() map[string]any { return d.Frontmatter }
+
+// Bytes returns the raw file content, including any frontmatter.
+func (d *Document) Bytes() []byte { return d.Source }
+
+// Headings walks the document and returns its headings in order. Slugs
+// are deduplicated the way the site generator does, with -1, -2 suffixes.
+func (d *Document) Headings() []Heading {
+	var out []Heading
+	slugger := NewSlugger()
+	ast.WalkFunc(d.AST, func(node ast.Node, entering bool) ast.WalkStatus {
+		if h, ok := node.(*ast.Heading); ok && entering {
+			text := nodeText(h)
+			out = append(out, Heading{
+				Level: h.Level,
+				Text:  text,
+				Slug:  slugger.Slug(text),
+			})
+		}
+		return ast.GoToNext
+	})
+	return out
+}
+
+// BlockKind classifies a prose block by the construct it came from.
+type BlockKind string
+
+const (
+	BlockParagraph  BlockKind = "paragraph"
+	BlockHeading    BlockKind = "heading"
+	BlockListItem   BlockKind = "list_item"
+	BlockTableCell  BlockKind = "table_cell"
+	BlockBlockquote BlockKind = "blockquote"
+)
+
+// Block is one prose-bearing block of a document with markup stripped.
+type Block struct {
+	Kind BlockKind
+	Text string
+}
+
+// Blocks returns the document's prose blocks in order: paragraph,
+// heading, list item, blockquote, and table text. Fenced code blocks
+// and raw HTML are excluded. Paragraphs inside list items count as
+// list items; paragraphs inside blockquotes count as blockquotes,
+// letting callers include or drop quoted text as a group.
+func (d *Document) Blocks() []Block {
+	var blocks []Block
+	listDepth, quoteDepth := 0, 0
+	ast.WalkFunc(d.AST, func(node ast.Node, entering bool) ast.WalkStatus {
+		if _, ok := node.(*ast.ListItem); ok {
+			if entering {
+				listDepth++
+			} else {
+				listDepth--
+			}
+			return ast.GoToNext
+		}
+		if _, ok := node.(*ast.BlockQuote); ok {
+			if entering {
+				quoteDepth++
+			} else {
+				quoteDepth--
+			}
+			return ast.GoToNext
+		}
+		if !entering {
+			return ast.GoToNext
+		}
+		var kind BlockKind
+		switch node.(type) {
+		case *ast.CodeBlock, *ast.HTMLBlock:
+			return ast.SkipChildren
+		case *ast.Paragraph:
+			kind = BlockParagraph
+			switch {
+			case listDepth > 0:
+				kind = BlockListItem
+			case quoteDepth > 0:
+				kind = BlockBlockquote
+			}
+		case *ast.Heading:
+			kind = BlockHeading
+		case *ast.TableCell:
+			kind = BlockTableCell
+		default:
+			return ast.GoToNext
+		}
+		if text := nodeText(node); text != "" {
+			blocks = append(blocks, Block{Kind: kind, Text: text})
+		}
+		return ast.SkipChildren
+	})
+	return blocks
+}
+
+// CodeLines counts the lines inside fenced and indented code blocks.
+func (d *Document) CodeLines() int {
+	total := 0
+	ast.WalkFunc(d.AST, func(node ast.Node, entering bool) ast.WalkStatus {
+		if cb, ok := node.(*ast.CodeBlock); ok && entering {
+			total += len(bytes.Split(bytes.TrimRight(cb.Literal, "\n"), []byte("\n")))
+			return ast.SkipChildren
+		}
+		return ast.GoToNext
+	})
+	return total
+}
+
+// Prose returns the document's prose blocks joined by blank lines.
+func (d *Document) Prose() string {
+	blocks := d.Blocks()
+	texts := make([]string, len(blocks))
+	for i, b := range blocks {
+		texts[i] = b.Text
+	}
+	return strings.Join(texts, "\n\n")
+}
+
+// nodeText collects the plain text beneath a node, dropping inline markup
+// but keeping inline code spans, which read as single words.
+func nodeText(node ast.Node) string {
+	var sb strings.Builder
+	ast.WalkFunc(node, func(n ast.Node, entering bool) ast.WalkStatus {
+		if !entering {
+			return ast.GoToNext
+		}
+		switch t := n.(type) {
+		case *ast.Text:
+			sb.Write(t.Literal)
+		case *ast.Code:
+			sb.Write(t.Literal)
+		case *ast.HTMLSpan:
+			return ast.SkipChildren
+		}
+		return ast.GoToNext
+	})
+	return strings.Join(strings.Fields(sb.String()), " ")
+}
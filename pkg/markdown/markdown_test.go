@@ -0,0 +1,153 @@
+package markdown
+
+import (
+	"strings"
+	"testing"
+)
+
+const sample = `---
+title: Sample
+---
+# Sample Page
+
+Intro paragraph.
+
+## Getting Started
+
+### Getting Started
+
+Some prose here.
+
+## CLI & Flags
+
+` + "```bash\necho not prose\n```" + `
+`
+
+func TestParseFrontmatter(t *testing.T) {
+	doc, err := Parse("sample.md", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := doc.Frontmatter["title"]; got != "Sample" {
+		t.Errorf("Frontmatter[title] = %v, want Sample", got)
+	}
+}
+
+func TestParseUnclosedFrontmatter(t *testing.T) {
+	src := []byte("---\ntitle: Broken\n\n# Heading\n\nBody text survives.\n")
+	doc, err := Parse("broken.md", []byte(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !doc.FrontmatterUnclosed {
+		t.Error("FrontmatterUnclosed = false, want true")
+	}
+	if doc.Frontmatter != nil {
+		t.Errorf("Frontmatter = %v, want nil", doc.Frontmatter)
+	}
+	if !strings.Contains(doc.Prose(), "Body text survives.") {
+		t.Errorf("body swallowed as frontmatter: %q", doc.Prose())
+	}
+}
+
+func TestParseKeepFrontmatter(t *testing.T) {
+	doc, err := ParseKeepFrontmatter("sample.md", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(doc.Prose(), "title: Sample") {
+		t.Errorf("Prose() dropped the kept frontmatter: %q", doc.Prose())
+	}
+}
+
+func TestHeadings(t *testing.T) {
+	doc, err := Parse("sample.md", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hs := doc.Headings()
+	want := []Heading{
+		{Level: 1, Text: "Sample Page", Slug: "sample-page"},
+		{Level: 2, Text: "Getting Started", Slug: "getting-started"},
+		{Level: 3, Text: "Getting Started", Slug: "getting-started-1"},
+		{Level: 2, Text: "CLI & Flags", Slug: "cli-flags"},
+	}
+	if len(hs) != len(want) {
+		t.Fatalf("got %d headings, want %d: %+v", len(hs), len(want), hs)
+	}
+	for i, h := range hs {
+		if h != want[i] {
+			t.Errorf("heading %d = %+v, want %+v", i, h, want[i])
+		}
+	}
+}
+
+func TestProseExcludesCode(t *testing.T) {
+	doc, err := Parse("sample.md", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prose := doc.Prose()
+	if strings.Contains(prose, "not prose") {
+		t.Errorf("Prose() includes code block content: %q", prose)
+	}
+	if !strings.Contains(prose, "Intro paragraph.") {
+		t.Errorf("Prose() missing paragraph text: %q", prose)
+	}
+}
+
+func TestTOC(t *testing.T) {
+	doc, err := Parse("sample.md", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := doc.TOC(2, 3)
+	want := "- [Getting Started](#getting-started)\n" +
+		"    - [Getting Started](#getting-started-1)\n" +
+		"- [CLI & Flags](#cli-flags)\n"
+	if got != want {
+		t.Errorf("TOC(2, 3) = %q, want %q", got, want)
+	}
+}
+
+func TestInsertTOC(t *testing.T) {
+	src := []byte("# Title\n\n<!-- toc -->\nstale\n<!-- /toc -->\n\nBody.\n")
+	out, ok := InsertTOC(src, "- [A](#a)\n")
+	if !ok {
+		t.Fatal("InsertTOC did not find markers")
+	}
+	want := "# Title\n\n<!-- toc -->\n\n- [A](#a)\n\n<!-- /toc -->\n\nBody.\n"
+	if string(out) != want {
+		t.Errorf("InsertTOC = %q, want %q", out, want)
+	}
+
+	if _, ok := InsertTOC([]byte("no markers"), "x"); ok {
+		t.Error("InsertTOC reported success without markers")
+	}
+}
+
+func TestParseCRLF(t *testing.T) {
+	lf := []byte(sample)
+	crlf := []byte(strings.ReplaceAll(sample, "\n", "\r\n"))
+
+	ldoc, err := Parse("lf.md", lf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cdoc, err := Parse("crlf.md", crlf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cdoc.Frontmatter["title"]; got != "Sample" {
+		t.Errorf("CRLF Frontmatter[title] = %v, want Sample", got)
+	}
+	if got, want := cdoc.Prose(), ldoc.Prose(); got != want {
+		t.Errorf("CRLF prose = %q, want LF-identical %q", got, want)
+	}
+	if got, want := len(cdoc.Headings()), len(ldoc.Headings()); got != want {
+		t.Errorf("CRLF headings = %d, want %d", got, want)
+	}
+	if strings.Contains(string(cdoc.Source), "\r") {
+		t.Error("carriage returns survived normalization")
+	}
+}
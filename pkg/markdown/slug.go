@@ -0,0 +1,49 @@
+package markdown
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Slugger produces document-unique anchor slugs. Repeated headings get
+// -1, -2 suffixes, matching the site generator's toc extension.
+type Slugger struct {
+	seen map[string]int
+}
+
+// NewSlugger returns a Slugger with an empty history.
+func NewSlugger() *Slugger {
+	return &Slugger{seen: make(map[string]int)}
+}
+
+// Slug returns the unique anchor for text within this document.
+func (s *Slugger) Slug(text string) string {
+	slug := Slugify(text)
+	n, dup := s.seen[slug]
+	s.seen[slug] = n + 1
+	if dup {
+		return slug + "-" + strconv.Itoa(n)
+	}
+	return slug
+}
+
+// Slugify converts heading text to its anchor form: lowercased, with
+// punctuation dropped and whitespace collapsed to single hyphens.
+func Slugify(text string) string {
+	var sb strings.Builder
+	prevHyphen := false
+	for _, r := range strings.ToLower(strings.TrimSpace(text)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			sb.WriteRune(r)
+			prevHyphen = false
+		case r == ' ' || r == '-':
+			if !prevHyphen && sb.Len() > 0 {
+				sb.WriteByte('-')
+				prevHyphen = true
+			}
+		}
+	}
+	return strings.TrimSuffix(sb.String(), "-")
+}
@@ -0,0 +1,59 @@
+package markdown
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TOC markers delimit the region of a document that holds a generated
+// table of contents.
+const (
+	TOCOpen  = "<!-- toc -->"
+	TOCClose = "<!-- /toc -->"
+)
+
+// TOC renders a markdown table of contents for the document: a nested
+// bullet list of anchor links for headings between minDepth and maxDepth
+// inclusive. Indentation follows the heading levels actually present, so
+// a document that skips a level does not produce dangling indents.
+func (d *Document) TOC(minDepth, maxDepth int) string {
+	var sb strings.Builder
+	var levels []int
+	for _, h := range d.Headings() {
+		if h.Level < minDepth || h.Level > maxDepth || h.Text == "" {
+			continue
+		}
+		for len(levels) > 0 && levels[len(levels)-1] >= h.Level {
+			levels = levels[:len(levels)-1]
+		}
+		levels = append(levels, h.Level)
+		indent := strings.Repeat("    ", len(levels)-1)
+		fmt.Fprintf(&sb, "%s- [%s](#%s)\n", indent, h.Text, h.Slug)
+	}
+	return sb.String()
+}
+
+// InsertTOC returns src with the region between the TOC markers replaced
+// by toc. It reports whether the markers were found; src is returned
+// unchanged when they are missing or out of order.
+func InsertTOC(src []byte, toc string) ([]byte, bool) {
+	text := string(src)
+	open := strings.Index(text, TOCOpen)
+	if open < 0 {
+		return src, false
+	}
+	end := strings.Index(text[open:], TOCClose)
+	if end < 0 {
+		return src, false
+	}
+	end += open
+
+	var sb strings.Builder
+	sb.WriteString(text[:open])
+	sb.WriteString(TOCOpen)
+	sb.WriteString("\n\n")
+	sb.WriteString(toc)
+	sb.WriteString("\n")
+	sb.WriteString(text[end:])
+	return []byte(sb.String()), true
+}
@@ -0,0 +1,99 @@
+// Package notebook extracts the markdown cells from Jupyter .ipynb
+// files so the readability pipeline can treat notebook tutorials like
+// any other document. Markdown cells are concatenated and parsed as
+// one markdown document; code cells count toward code lines but never
+// contribute prose, and outputs are ignored entirely.
+package notebook
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+// cell is the subset of the nbformat cell schema the extractor needs.
+// Source is a string in some exporters and a list of lines in others;
+// sourceText accepts both.
+type cell struct {
+	CellType string          `json:"cell_type"`
+	Source   json.RawMessage `json:"source"`
+}
+
+type rawNotebook struct {
+	Cells []cell `json:"cells"`
+}
+
+// Document is the parsed representation of a notebook: the markdown
+// cells as one markdown document, plus the code-cell line count.
+type Document struct {
+	Path   string
+	Source []byte
+
+	md        *markdown.Document
+	codeLines int
+}
+
+// Parse decodes src as an nbformat notebook and extracts its markdown
+// cells.
+func Parse(path string, src []byte) (*Document, error) {
+	var nb rawNotebook
+	if err := json.Unmarshal(src, &nb); err != nil {
+		return nil, fmt.Errorf("parsing notebook %s: %w", path, err)
+	}
+
+	d := &Document{Path: path, Source: src}
+	var cells []string
+	for _, c := range nb.Cells {
+		text, err := sourceText(c.Source)
+		if err != nil {
+			return nil, fmt.Errorf("parsing notebook %s: %w", path, err)
+		}
+		switch c.CellType {
+		case "markdown":
+			cells = append(cells, text)
+		case "code":
+			if text != "" {
+				d.codeLines += strings.Count(strings.TrimSuffix(text, "\n"), "\n") + 1
+			}
+		}
+	}
+
+	md, err := markdown.Parse(path, []byte(strings.Join(cells, "\n\n")))
+	if err != nil {
+		return nil, err
+	}
+	d.md = md
+	return d, nil
+}
+
+// sourceText decodes a cell source, which nbformat allows as either a
+// single string or a list of lines.
+func sourceText(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 {
+		return "", nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s, nil
+	}
+	var lines []string
+	if err := json.Unmarshal(raw, &lines); err != nil {
+		return "", err
+	}
+	return strings.Join(lines, ""), nil
+}
+
+// Bytes returns the raw file content.
+func (d *Document) Bytes() []byte { return d.Source }
+
+// Blocks returns the prose blocks from the markdown cells in order.
+func (d *Document) Blocks() []markdown.Block { return d.md.Blocks() }
+
+// Headings returns the headings from the markdown cells in order.
+func (d *Document) Headings() []markdown.Heading { return d.md.Headings() }
+
+// CodeLines counts code-cell lines plus code blocks inside markdown
+// cells.
+func (d *Document) CodeLines() int { return d.codeLines + d.md.CodeLines() }
@@ -0,0 +1,68 @@
+package notebook
+
+import (
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+const sample = `{
+  "cells": [
+    {
+      "cell_type": "markdown",
+      "source": ["# Tutorial\n", "\n", "Intro paragraph for the tutorial.\n"]
+    },
+    {
+      "cell_type": "code",
+      "source": ["import os\n", "print(os.getcwd())\n"],
+      "outputs": [{"output_type": "stream", "text": ["/home\n"]}]
+    },
+    {
+      "cell_type": "markdown",
+      "source": "## Next Steps\n\nAnother paragraph as a single string."
+    }
+  ],
+  "nbformat": 4
+}`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse("tutorial.ipynb", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs := doc.Headings()
+	if len(hs) != 2 {
+		t.Fatalf("got %d headings, want 2: %+v", len(hs), hs)
+	}
+	if hs[0].Level != 1 || hs[0].Text != "Tutorial" {
+		t.Errorf("heading 0 = %+v", hs[0])
+	}
+	if hs[1].Level != 2 || hs[1].Text != "Next Steps" {
+		t.Errorf("heading 1 = %+v", hs[1])
+	}
+
+	if doc.CodeLines() != 2 {
+		t.Errorf("CodeLines = %d, want 2", doc.CodeLines())
+	}
+
+	var para int
+	for _, b := range doc.Blocks() {
+		if b.Kind != markdown.BlockParagraph {
+			continue
+		}
+		para++
+		if b.Text == "import os" || b.Text == "/home" {
+			t.Errorf("code or output leaked into prose: %q", b.Text)
+		}
+	}
+	if para != 2 {
+		t.Errorf("got %d paragraphs, want 2", para)
+	}
+}
+
+func TestParseRejectsInvalidJSON(t *testing.T) {
+	if _, err := Parse("broken.ipynb", []byte("not json")); err == nil {
+		t.Error("expected an error for invalid notebook JSON")
+	}
+}
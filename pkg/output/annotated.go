@@ -0,0 +1,38 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// Annotated prints every sentence of each file tagged with its own
+// Flesch-Kincaid grade, the view for a line-by-line editing pass.
+// Sentences at or above FlagGrade are marked so spikes stand out.
+type Annotated struct {
+	// FlagGrade marks sentences whose grade meets or exceeds it.
+	// Zero disables marking.
+	FlagGrade float64
+}
+
+// Format implements Formatter.
+func (a Annotated) Format(w io.Writer, results []*analyzer.Result) error {
+	for i, r := range results {
+		if r.Status == analyzer.StatusSkipped {
+			continue
+		}
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s (grade %.1f)\n", r.Path, r.Metrics.FleschKincaid)
+		for _, s := range r.Sentences {
+			mark := "  "
+			if a.FlagGrade > 0 && s.Grade >= a.FlagGrade {
+				mark = "! "
+			}
+			fmt.Fprintf(w, "%s[%5.1f] %s\n", mark, s.Grade, s.Text)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// Badge colors by pass rate, matching the shields.io palette.
+const (
+	badgeGreen  = "#4c1"
+	badgeYellow = "#dfb317"
+	badgeRed    = "#e05d44"
+)
+
+// Badge renders a shields.io-style SVG showing the corpus pass rate,
+// for embedding in a README. The SVG is self-contained and
+// deterministic — same results, same bytes — so CI can commit or
+// publish it as an artifact without noise.
+type Badge struct{}
+
+// Format implements Formatter.
+func (Badge) Format(w io.Writer, results []*analyzer.Result) error {
+	var agg Aggregate
+	for _, r := range results {
+		agg.Add(r)
+	}
+	rate := 0.0
+	if agg.Analyzed > 0 {
+		rate = float64(agg.Passed+agg.Warned) / float64(agg.Analyzed)
+	}
+	color := badgeRed
+	switch {
+	case rate >= 0.9:
+		color = badgeGreen
+	case rate >= 0.7:
+		color = badgeYellow
+	}
+	return writeBadge(w, "docs", fmt.Sprintf("%.0f%% readable", rate*100), color)
+}
+
+// writeBadge emits a flat-style badge with a gray label segment and a
+// colored value segment. Widths come from a fixed per-character
+// estimate rather than font metrics, keeping the output deterministic
+// across platforms.
+func writeBadge(w io.Writer, label, value, color string) error {
+	const pad, perChar = 10, 7
+	lw := pad + perChar*len(label)
+	vw := pad + perChar*len(value)
+	total := lw + vw
+	_, err := fmt.Fprintf(w, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="%s: %s">
+  <linearGradient id="s" x2="0" y2="100%%">
+    <stop offset="0" stop-color="#bbb" stop-opacity=".1"/>
+    <stop offset="1" stop-opacity=".1"/>
+  </linearGradient>
+  <rect width="%d" height="20" fill="#555"/>
+  <rect x="%d" width="%d" height="20" fill="%s"/>
+  <rect width="%d" height="20" fill="url(#s)"/>
+  <g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">
+    <text x="%d" y="14">%s</text>
+    <text x="%d" y="14">%s</text>
+  </g>
+</svg>
+`, total, label, value, lw, lw, vw, color, total, lw/2, label, lw+vw/2, value)
+	return err
+}
@@ -0,0 +1,163 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// Checklist renders a markdown task list grouped by file, one unchecked
+// box per concrete fix, for pasting into PR comments. Where the table
+// formats report status, this one drives action: each box names the
+// line to visit and the edit to make. Files with nothing to fix are
+// omitted.
+type Checklist struct{}
+
+// Format implements Formatter.
+func (Checklist) Format(w io.Writer, results []*analyzer.Result) error {
+	any := false
+	for _, r := range results {
+		items := checklistItems(r)
+		if len(items) == 0 {
+			continue
+		}
+		if any {
+			fmt.Fprintln(w)
+		}
+		any = true
+		fmt.Fprintf(w, "### %s\n\n", r.Path)
+		for _, item := range items {
+			fmt.Fprintf(w, "- [ ] %s\n", item)
+		}
+	}
+	if !any {
+		fmt.Fprintln(w, "No action items: every file is within thresholds.")
+	}
+	return nil
+}
+
+// itemizedChecks are the failure checks whose issues already appear as
+// individual boxes below; their summary failure would double-report.
+var itemizedChecks = map[string]bool{
+	"max_sentence_words":          true,
+	"max_heading_words":           true,
+	"max_consecutive_code_blocks": true,
+	"fail_on_empty_sections":      true,
+	"fail_on_heading_style":       true,
+	"fail_on_mixed_list_markers":  true,
+	"fail_on_broken_tables":       true,
+	"fail_on_broken_admonitions":  true,
+	"fail_on_terminology":         true,
+	"fail_on_prose_in_code":       true,
+	"fail_on_bare_urls":           true,
+	"fail_on_unlabeled_code":      true,
+	"fail_on_spelling":            true,
+	"fail_on_spelling_variants":   true,
+	"fail_on_whitespace":          true,
+	"fail_on_markdown_syntax":     true,
+	"fail_on_repeated_openings":   true,
+	"require_h1":                  true,
+}
+
+// checklistItems phrases a result's issues as edits to make, most
+// specific first so the line-numbered fixes lead.
+func checklistItems(r *analyzer.Result) []string {
+	if r.Status == analyzer.StatusError {
+		return []string{fmt.Sprintf("fix unreadable file: %s", r.Error)}
+	}
+	if r.Status == analyzer.StatusSkipped {
+		return nil
+	}
+	var items []string
+	add := func(format string, args ...any) {
+		items = append(items, fmt.Sprintf(format, args...))
+	}
+	for _, ls := range r.LongSentences {
+		if ls.Line > 0 {
+			add("shorten the %d-word sentence on line %d", ls.Words, ls.Line)
+		} else {
+			add("shorten the %d-word sentence starting %q", ls.Words, firstWords(ls.Text, 6))
+		}
+	}
+	for _, lh := range r.LongHeadings {
+		add("shorten the %d-word heading on line %d to a noun phrase", lh.Words, lh.Line)
+	}
+	for _, sb := range r.SuspectCodeBlocks {
+		add("close the code fence opened above line %d; %d of %d lines inside read as prose", sb.Line, sb.ProseLines, sb.TotalLines)
+	}
+	for _, cr := range r.CodeBlockRuns {
+		add("add a sentence of context between the %d stacked code blocks at line %d", cr.Count, cr.Line)
+	}
+	for _, uc := range r.UnlabeledCodeBlocks {
+		add("name a language on the code fence at line %d, or `text` for plain output", uc.Line)
+	}
+	for _, bu := range r.BareURLs {
+		add("wrap the bare URL %s on line %d in a link with descriptive text", bu.URL, bu.Line)
+	}
+	for _, ti := range r.TerminologyIssues {
+		add("replace %q with %q on line %d", ti.Term, ti.Preferred, ti.Line)
+	}
+	for _, ms := range r.Misspellings {
+		add("fix or whitelist the unknown word %q on line %d", ms.Word, ms.Line)
+	}
+	for _, sv := range r.SpellingVariantIssues {
+		add("unify the spelling: %s", sv)
+	}
+	for _, ws := range r.WhitespaceIssues {
+		add("remove the %s on line %d", strings.ReplaceAll(ws.Kind, "_", " "), ws.Line)
+	}
+	for _, si := range r.SyntaxIssues {
+		add("repair the markdown on line %d: %s", si.Line, si.Message)
+	}
+	for _, ro := range r.RepeatedOpenings {
+		if ro.Line > 0 {
+			add("vary the %d consecutive sentences opening with %q near line %d", ro.Count, ro.Word, ro.Line)
+		} else {
+			add("vary the %d consecutive sentences opening with %q", ro.Count, ro.Word)
+		}
+	}
+	for _, es := range r.EmptySections {
+		add("write content under the empty section %q or remove its heading", es)
+	}
+	for _, hs := range r.HeadingStyleIssues {
+		add("%s", hs)
+	}
+	for _, lm := range r.ListMarkerIssues {
+		add("normalize the list marker: %s", lm)
+	}
+	for _, h1 := range r.H1Issues {
+		add("fix the page title: %s", h1)
+	}
+	for _, ti := range r.TableIssues {
+		add("repair the table: %s", ti)
+	}
+	for _, ai := range r.AdmonitionIssues {
+		add("repair the admonition or definition list: %s", ai)
+	}
+	for _, fd := range r.Findings {
+		if fd.Line > 0 {
+			add("%s (line %d)", fd.Message, fd.Line)
+		} else {
+			add("%s", fd.Message)
+		}
+	}
+	// Metric-level failures with no itemized detail above still need a
+	// box, phrased from their message.
+	for _, f := range r.Failures {
+		if !itemizedChecks[f.Check] {
+			add("%s", f.Message)
+		}
+	}
+	return items
+}
+
+// firstWords truncates text to its first n words for box labels.
+func firstWords(text string, n int) string {
+	words := strings.Fields(text)
+	if len(words) <= n {
+		return text
+	}
+	return strings.Join(words[:n], " ") + " ..."
+}
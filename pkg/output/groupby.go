@@ -0,0 +1,101 @@
+package output
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// GroupBy modes for the markdown and summary formats. Frontmatter
+// grouping is spelled "frontmatter:<field>"; see GroupByFrontmatter.
+const (
+	GroupByDir    = "dir"
+	GroupByStatus = "status"
+
+	// GroupByFrontmatter prefixes the mode that groups by a frontmatter
+	// field's value, recorded per file in Result.Category.
+	GroupByFrontmatter = "frontmatter:"
+)
+
+// ValidGroupBy reports whether mode is a supported --group-by value;
+// empty means no grouping.
+func ValidGroupBy(mode string) bool {
+	switch mode {
+	case "", GroupByDir, GroupByStatus:
+		return true
+	}
+	return strings.HasPrefix(mode, GroupByFrontmatter) && mode != GroupByFrontmatter
+}
+
+// groupKey returns the section a result belongs to under mode: the
+// path's top-level directory ("." for files at the root), the result
+// status, or the file's frontmatter category.
+func groupKey(r *analyzer.Result, mode string) string {
+	if mode == GroupByStatus {
+		return string(r.Status)
+	}
+	if strings.HasPrefix(mode, GroupByFrontmatter) {
+		if r.Category == "" {
+			return "(uncategorized)"
+		}
+		return r.Category
+	}
+	path := filepath.ToSlash(r.Path)
+	if i := strings.IndexByte(path, '/'); i > 0 {
+		return path[:i]
+	}
+	return "."
+}
+
+// groupResults buckets results by groupKey, keeping groups in order of
+// first appearance so reports stay stable across runs.
+func groupResults(results []*analyzer.Result, mode string) ([]string, map[string][]*analyzer.Result) {
+	var order []string
+	groups := make(map[string][]*analyzer.Result)
+	for _, r := range results {
+		key := groupKey(r, mode)
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], r)
+	}
+	return order, groups
+}
+
+// subtotal renders a group's counters for the section footer.
+func subtotal(results []*analyzer.Result) string {
+	var agg Aggregate
+	for _, r := range results {
+		agg.Add(r)
+	}
+	s := fmt.Sprintf("%d analyzed, %d passed, %d failed", agg.Analyzed, agg.Passed, agg.Failed)
+	if sum, n := gradeSum(results); n > 0 {
+		s += fmt.Sprintf(", avg grade %.1f", sum/float64(n))
+	}
+	if agg.Warned > 0 {
+		s += fmt.Sprintf(", %d warned", agg.Warned)
+	}
+	if agg.Skipped > 0 {
+		s += fmt.Sprintf(", %d skipped", agg.Skipped)
+	}
+	if agg.Errored > 0 {
+		s += fmt.Sprintf(", %d errored", agg.Errored)
+	}
+	return s
+}
+
+// gradeSum totals flesch-kincaid grades across a group's scored files,
+// for the per-group average in subtotals.
+func gradeSum(results []*analyzer.Result) (float64, int) {
+	sum, n := 0.0, 0
+	for _, r := range results {
+		if r.Status == analyzer.StatusSkipped || r.Status == analyzer.StatusError {
+			continue
+		}
+		sum += r.Metrics.FleschKincaid
+		n++
+	}
+	return sum, n
+}
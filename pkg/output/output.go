@@ -0,0 +1,328 @@
+// Package output renders analysis results in the formats the CLI and CI
+// consume.
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/config"
+)
+
+// Formatter renders a set of results to a writer.
+type Formatter interface {
+	Format(w io.Writer, results []*analyzer.Result) error
+}
+
+// New returns the formatter for a --format value.
+func New(format string) (Formatter, error) {
+	switch format {
+	case "text", "":
+		return Text{}, nil
+	case "json":
+		return JSON{}, nil
+	case "ndjson":
+		return NDJSON{}, nil
+	case "markdown":
+		return Markdown{}, nil
+	case "checklist":
+		return Checklist{}, nil
+	case "annotated":
+		return Annotated{}, nil
+	case "summary":
+		return Summary{}, nil
+	case "badge":
+		return Badge{}, nil
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+}
+
+// Text is the default human-readable terminal format: one row per file
+// with the headline metrics, followed by failure details and a summary.
+type Text struct {
+	// Color paints the status column with ANSI codes: red for
+	// fail/error, yellow for borderline passes, green for clean ones.
+	// The CLI enables it only on a TTY, honoring NO_COLOR.
+	Color bool
+}
+
+// ANSI color codes for Text.Color; see statusColor.
+const (
+	colorRed    = "31"
+	colorGreen  = "32"
+	colorYellow = "33"
+)
+
+// Format implements Formatter.
+func (t Text) Format(w io.Writer, results []*analyzer.Result) error {
+	var flags uint
+	if t.Color {
+		// StripEscape keeps \xff-bracketed ANSI codes out of column
+		// width calculations, so colored tables still align.
+		flags = tabwriter.StripEscape
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', flags)
+	for _, r := range results {
+		if r.Status == analyzer.StatusSkipped {
+			fmt.Fprintf(tw, "%s\tskipped\t%s\n", r.Path, r.SkipReason)
+			continue
+		}
+		if r.Status == analyzer.StatusError {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Path, t.paint("error", colorRed), r.Error)
+			continue
+		}
+		m := r.Metrics
+		status := t.paint(string(r.Status), t.statusColor(r))
+		profile := ""
+		if r.Profile != "" {
+			profile = "\tprofile " + r.Profile
+		}
+		fmt.Fprintf(tw, "%s\t%s\tgrade %.1f\t%s\tari %.1f\tfog %.1f\tease %.1f\twords %d\tlines %d\tcode %.0f%%%s\n",
+			r.Path, status, m.FleschKincaid, m.GradeLabel, m.ARI, m.GunningFog, m.FleschEase, m.Words, m.Lines, m.CodeRatio*100, profile)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	failed, warned, skipped, errored := 0, 0, 0, 0
+	for _, r := range results {
+		if r.Status == analyzer.StatusSkipped {
+			skipped++
+		}
+		if r.Status == analyzer.StatusWarn {
+			warned++
+		}
+		if r.Status == analyzer.StatusError {
+			errored++
+		}
+		for _, mg := range r.Margins {
+			fmt.Fprintf(w, "  %s: %s %.1f / %.1f (%.0f%% of budget)\n", r.Path, mg.Check, mg.Value, mg.Limit, mg.Percent)
+		}
+		for _, ms := range r.Misspellings {
+			fmt.Fprintf(w, "  %s:%d: unknown word %q\n", r.Path, ms.Line, ms.Word)
+		}
+		for _, sv := range r.SpellingVariantIssues {
+			fmt.Fprintf(w, "  %s: %s\n", r.Path, sv)
+		}
+		for _, ws := range r.WhitespaceIssues {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, ws.Line, strings.ReplaceAll(ws.Kind, "_", " "))
+		}
+		for _, si := range r.SyntaxIssues {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, si.Line, si.Message)
+		}
+		for _, es := range r.EmptySections {
+			fmt.Fprintf(w, "  %s: empty section: %s\n", r.Path, es)
+		}
+		for _, hs := range r.HeadingStyleIssues {
+			fmt.Fprintf(w, "  %s: %s\n", r.Path, hs)
+		}
+		for _, lm := range r.ListMarkerIssues {
+			fmt.Fprintf(w, "  %s: %s\n", r.Path, lm)
+		}
+		for _, h1 := range r.H1Issues {
+			fmt.Fprintf(w, "  %s: %s\n", r.Path, h1)
+		}
+		for _, ti := range r.TableIssues {
+			fmt.Fprintf(w, "  %s: %s\n", r.Path, ti)
+		}
+		for _, ai := range r.AdmonitionIssues {
+			fmt.Fprintf(w, "  %s: %s\n", r.Path, ai)
+		}
+		for _, ti := range r.TerminologyIssues {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, ti.Line, ti)
+		}
+		for _, cr := range r.CodeBlockRuns {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, cr.Line, cr)
+		}
+		for _, sb := range r.SuspectCodeBlocks {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, sb.Line, sb)
+		}
+		for _, lh := range r.LongHeadings {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, lh.Line, lh)
+		}
+		for _, bu := range r.BareURLs {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, bu.Line, bu)
+		}
+		for _, uc := range r.UnlabeledCodeBlocks {
+			fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, uc.Line, uc)
+		}
+		for _, ls := range r.LongSentences {
+			if ls.Line > 0 {
+				fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, ls.Line, ls)
+			} else {
+				fmt.Fprintf(w, "  %s: %s\n", r.Path, ls)
+			}
+		}
+		for _, ro := range r.RepeatedOpenings {
+			if ro.Line > 0 {
+				fmt.Fprintf(w, "  %s:%d: %s\n", r.Path, ro.Line, ro)
+			} else {
+				fmt.Fprintf(w, "  %s: %s\n", r.Path, ro)
+			}
+		}
+		for _, fd := range r.Findings {
+			if fd.Line > 0 {
+				fmt.Fprintf(w, "  %s:%d: %s: %s\n", r.Path, fd.Line, fd.Check, fd.Message)
+			} else {
+				fmt.Fprintf(w, "  %s: %s: %s\n", r.Path, fd.Check, fd.Message)
+			}
+		}
+		if r.Status == analyzer.StatusFail {
+			failed++
+		}
+		for _, f := range r.Failures {
+			fmt.Fprintf(w, "  %s: %s: %s\n", r.Path, f.Severity, f.Message)
+		}
+	}
+	fmt.Fprintf(w, "\n%d files analyzed, %d failed, %d skipped", len(results)-skipped, failed, skipped)
+	if warned > 0 {
+		fmt.Fprintf(w, ", %d warned", warned)
+	}
+	if errored > 0 {
+		fmt.Fprintf(w, ", %d errored", errored)
+	}
+	fmt.Fprintln(w)
+	return nil
+}
+
+// statusColor picks the color for a scored row.
+func (Text) statusColor(r *analyzer.Result) string {
+	switch {
+	case r.Status == analyzer.StatusFail:
+		return colorRed
+	case r.Status == analyzer.StatusWarn || r.Borderline:
+		return colorYellow
+	default:
+		return colorGreen
+	}
+}
+
+// paint wraps s in an ANSI color, bracketing the escape sequences with
+// tabwriter's escape byte so they do not skew column widths. A no-op
+// when Color is off.
+func (t Text) paint(s, color string) string {
+	if !t.Color {
+		return s
+	}
+	const esc = "\xff"
+	return esc + "\x1b[" + color + "m" + esc + s + esc + "\x1b[0m" + esc
+}
+
+// JSON renders results as a JSON array, pretty-printed by default.
+type JSON struct {
+	// Compact emits the array on a single line with no indentation,
+	// for pipelines where the bytes matter more than readability.
+	Compact bool
+}
+
+// Format implements Formatter.
+func (j JSON) Format(w io.Writer, results []*analyzer.Result) error {
+	enc := json.NewEncoder(w)
+	if !j.Compact {
+		enc.SetIndent("", "  ")
+	}
+	return enc.Encode(results)
+}
+
+// JSONEnvelope renders the JSON array inside a self-describing header:
+// tool version, timestamp, the analyzed path, and the thresholds the
+// run enforced. Archived reports then carry enough context to audit or
+// reproduce without the config file that produced them.
+type JSONEnvelope struct {
+	// Version is the tool version stamped into the header.
+	Version string
+
+	// Path is the path the run analyzed, as given on the command line.
+	Path string
+
+	// Thresholds are the resolved base thresholds the run enforced,
+	// flag and environment overrides included.
+	Thresholds config.Thresholds
+
+	// Compact emits the envelope on a single line; see JSON.Compact.
+	Compact bool
+}
+
+// Format implements Formatter.
+func (e JSONEnvelope) Format(w io.Writer, results []*analyzer.Result) error {
+	envelope := struct {
+		Tool       string             `json:"tool"`
+		Version    string             `json:"version"`
+		Timestamp  string             `json:"timestamp"`
+		Path       string             `json:"path"`
+		Thresholds config.Thresholds  `json:"thresholds"`
+		Results    []*analyzer.Result `json:"results"`
+	}{
+		Tool:       "readability",
+		Version:    e.Version,
+		Timestamp:  time.Now().UTC().Format(time.RFC3339),
+		Path:       e.Path,
+		Thresholds: e.Thresholds,
+		Results:    results,
+	}
+	enc := json.NewEncoder(w)
+	if !e.Compact {
+		enc.SetIndent("", "  ")
+	}
+	return enc.Encode(envelope)
+}
+
+// NDJSON renders one Result JSON object per line, the buffered
+// counterpart of the CLI's streaming mode, for jq-style incremental
+// consumers.
+type NDJSON struct{}
+
+// Format implements Formatter.
+func (NDJSON) Format(w io.Writer, results []*analyzer.Result) error {
+	enc := json.NewEncoder(w)
+	for _, r := range results {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Markdown renders a report table suitable for job summaries and PR
+// comments.
+type Markdown struct {
+	// GroupBy splits the flat table into sections with subtotals, by
+	// top-level directory or by status; see the GroupBy constants.
+	// Empty keeps the single table.
+	GroupBy string
+}
+
+// Format implements Formatter.
+func (f Markdown) Format(w io.Writer, results []*analyzer.Result) error {
+	if f.GroupBy == "" {
+		markdownTable(w, results)
+		return nil
+	}
+	order, groups := groupResults(results, f.GroupBy)
+	for i, key := range order {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "## %s\n\n", key)
+		markdownTable(w, groups[key])
+		fmt.Fprintf(w, "\n%s\n", subtotal(groups[key]))
+	}
+	return nil
+}
+
+// markdownTable writes the per-file report table.
+func markdownTable(w io.Writer, results []*analyzer.Result) {
+	fmt.Fprintln(w, "| File | Status | Grade | Level | ARI | Fog | Ease | Words | Lines |")
+	fmt.Fprintln(w, "| ---- | ------ | ----- | ----- | --- | --- | ---- | ----- | ----- |")
+	for _, r := range results {
+		m := r.Metrics
+		fmt.Fprintf(w, "| %s | %s | %.1f | %s | %.1f | %.1f | %.1f | %d | %d |\n",
+			r.Path, r.Status, m.FleschKincaid, m.GradeLabel, m.ARI, m.GunningFog, m.FleschEase, m.Words, m.Lines)
+	}
+}
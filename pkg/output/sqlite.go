@@ -0,0 +1,91 @@
+package output
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// sqliteSchema holds per-run metadata in runs and one row per file per
+// run in results, so trends are a GROUP BY away without a separate
+// ingestion pipeline.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id        INTEGER PRIMARY KEY AUTOINCREMENT,
+	timestamp TEXT NOT NULL,
+	git_sha   TEXT
+);
+CREATE TABLE IF NOT EXISTS results (
+	run_id       INTEGER NOT NULL REFERENCES runs(id),
+	path         TEXT NOT NULL,
+	status       TEXT NOT NULL,
+	grade        REAL,
+	ari          REAL,
+	fog          REAL,
+	ease         REAL,
+	coleman_liau REAL,
+	smog         REAL,
+	words        INTEGER,
+	sentences    INTEGER,
+	lines        INTEGER,
+	code_ratio   REAL,
+	failures     INTEGER
+);
+CREATE INDEX IF NOT EXISTS results_path ON results (path, run_id);
+`
+
+// ExportSQLite appends one run's per-file results to the SQLite
+// database at path, creating the schema when absent. gitSHA may be
+// empty. All writes happen in a single transaction, so a failed export
+// never leaves a half-recorded run.
+func ExportSQLite(path string, results []*analyzer.Result, gitSHA string) error {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return fmt.Errorf("sqlite export: %w", err)
+	}
+	defer db.Close()
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		return fmt.Errorf("sqlite export: %w", err)
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return fmt.Errorf("sqlite export: %w", err)
+	}
+	defer tx.Rollback()
+
+	run, err := tx.Exec("INSERT INTO runs (timestamp, git_sha) VALUES (?, ?)",
+		time.Now().UTC().Format(time.RFC3339), gitSHA)
+	if err != nil {
+		return fmt.Errorf("sqlite export: %w", err)
+	}
+	runID, err := run.LastInsertId()
+	if err != nil {
+		return fmt.Errorf("sqlite export: %w", err)
+	}
+
+	stmt, err := tx.Prepare(`INSERT INTO results
+		(run_id, path, status, grade, ari, fog, ease, coleman_liau, smog,
+		 words, sentences, lines, code_ratio, failures)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return fmt.Errorf("sqlite export: %w", err)
+	}
+	defer stmt.Close()
+	for _, r := range results {
+		m := r.Metrics
+		if _, err := stmt.Exec(runID, r.Path, string(r.Status),
+			m.FleschKincaid, m.ARI, m.GunningFog, m.FleschEase, m.ColemanLiau, m.SMOG,
+			m.Words, m.Sentences, m.Lines, m.CodeRatio, len(r.Failures)); err != nil {
+			return fmt.Errorf("sqlite export: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("sqlite export: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package output
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+func TestExportSQLiteRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "readability.db")
+	results := []*analyzer.Result{
+		{Path: "a.md", Status: analyzer.StatusPass,
+			Metrics: analyzer.Metrics{FleschKincaid: 8.5, Words: 120, Lines: 40}},
+		{Path: "b.md", Status: analyzer.StatusFail,
+			Metrics:  analyzer.Metrics{FleschKincaid: 14.2},
+			Failures: []analyzer.Failure{{Check: "max_grade"}}},
+	}
+	if err := ExportSQLite(path, results, "abc123"); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var sha string
+	if err := db.QueryRow("SELECT git_sha FROM runs").Scan(&sha); err != nil {
+		t.Fatal(err)
+	}
+	if sha != "abc123" {
+		t.Errorf("git_sha = %q, want abc123", sha)
+	}
+
+	var status string
+	var grade float64
+	var failures int
+	err = db.QueryRow("SELECT status, grade, failures FROM results WHERE path = 'a.md'").
+		Scan(&status, &grade, &failures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != "pass" || grade != 8.5 || failures != 0 {
+		t.Errorf("a.md row = (%s, %g, %d), want (pass, 8.5, 0)", status, grade, failures)
+	}
+	err = db.QueryRow("SELECT status, failures FROM results WHERE path = 'b.md'").
+		Scan(&status, &failures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != "fail" || failures != 1 {
+		t.Errorf("b.md row = (%s, %d), want (fail, 1)", status, failures)
+	}
+}
+
+func TestExportSQLiteAppendsRuns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "readability.db")
+	results := []*analyzer.Result{{Path: "a.md", Status: analyzer.StatusPass}}
+	if err := ExportSQLite(path, results, "one"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ExportSQLite(path, results, ""); err != nil {
+		t.Fatal(err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	var runs, rows, runIDs int
+	if err := db.QueryRow("SELECT COUNT(*) FROM runs").Scan(&runs); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.QueryRow("SELECT COUNT(*), COUNT(DISTINCT run_id) FROM results").Scan(&rows, &runIDs); err != nil {
+		t.Fatal(err)
+	}
+	if runs != 2 || rows != 2 || runIDs != 2 {
+		t.Errorf("after two exports: %d runs, %d result rows over %d run ids, want 2/2/2", runs, rows, runIDs)
+	}
+}
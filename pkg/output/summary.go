@@ -0,0 +1,87 @@
+package output
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// Aggregate folds results into running counters, so consumers that
+// only need totals — the summary format, --check on a huge corpus —
+// can drop each result as soon as it is counted instead of holding the
+// whole run in memory.
+type Aggregate struct {
+	Analyzed int
+	Passed   int
+	Warned   int
+	Failed   int
+	Skipped  int
+	Errored  int
+}
+
+// Add counts one result.
+func (a *Aggregate) Add(r *analyzer.Result) {
+	switch r.Status {
+	case analyzer.StatusSkipped:
+		a.Skipped++
+		return
+	case analyzer.StatusPass:
+		a.Passed++
+	case analyzer.StatusWarn:
+		a.Warned++
+	case analyzer.StatusFail:
+		a.Failed++
+	case analyzer.StatusError:
+		a.Errored++
+	}
+	a.Analyzed++
+}
+
+// Write renders the counters as the one-line run summary.
+func (a *Aggregate) Write(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "%d files analyzed, %d failed, %d skipped", a.Analyzed, a.Failed, a.Skipped)
+	if err != nil {
+		return err
+	}
+	if a.Warned > 0 {
+		if _, err := fmt.Fprintf(w, ", %d warned", a.Warned); err != nil {
+			return err
+		}
+	}
+	if a.Errored > 0 {
+		if _, err := fmt.Fprintf(w, ", %d errored", a.Errored); err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintln(w)
+	return err
+}
+
+// Summary prints only the run totals, no per-file rows. The CLI pairs
+// it with streaming aggregation so massive runs never buffer results.
+type Summary struct {
+	// GroupBy prefixes the total with per-group count lines; see the
+	// GroupBy constants. Empty keeps the single line.
+	GroupBy string
+}
+
+// Format implements Formatter for collected runs, e.g. with --output.
+func (f Summary) Format(w io.Writer, results []*analyzer.Result) error {
+	if f.GroupBy != "" {
+		order, groups := groupResults(results, f.GroupBy)
+		for _, key := range order {
+			if _, err := fmt.Fprintf(w, "%s: %s\n", key, subtotal(groups[key])); err != nil {
+				return err
+			}
+		}
+		if _, err := fmt.Fprintln(w); err != nil {
+			return err
+		}
+	}
+	var agg Aggregate
+	for _, r := range results {
+		agg.Add(r)
+	}
+	return agg.Write(w)
+}
@@ -0,0 +1,100 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/template"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// Template renders results through a user-supplied Go text/template,
+// for bespoke report shapes no built-in format covers. The template
+// executes against TemplateData: .Results holds the full result slice
+// and .Aggregate the run totals. Helper functions:
+//
+//	filterStatus "fail" .Results   results with that status
+//	sortBy "grade" .Results        sorted worst-first by grade, ari,
+//	                               fog, ease, words, or lines; "path"
+//	                               sorts ascending
+//	pct 3 12                       3/12 as a percentage
+type Template struct {
+	tmpl *template.Template
+}
+
+// TemplateData is the root object a --template file executes against.
+type TemplateData struct {
+	Results   []*analyzer.Result
+	Aggregate Aggregate
+}
+
+// NewTemplate parses path as the report template.
+func NewTemplate(path string) (Template, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Template{}, err
+	}
+	tmpl, err := template.New("report").Funcs(templateFuncs).Parse(string(data))
+	if err != nil {
+		return Template{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return Template{tmpl: tmpl}, nil
+}
+
+// Format implements Formatter.
+func (t Template) Format(w io.Writer, results []*analyzer.Result) error {
+	var agg Aggregate
+	for _, r := range results {
+		agg.Add(r)
+	}
+	return t.tmpl.Execute(w, TemplateData{Results: results, Aggregate: agg})
+}
+
+// templateFuncs are the helpers in scope inside a --template file.
+var templateFuncs = template.FuncMap{
+	"filterStatus": func(status string, results []*analyzer.Result) []*analyzer.Result {
+		var out []*analyzer.Result
+		for _, r := range results {
+			if string(r.Status) == status {
+				out = append(out, r)
+			}
+		}
+		return out
+	},
+	"sortBy": func(key string, results []*analyzer.Result) ([]*analyzer.Result, error) {
+		out := make([]*analyzer.Result, len(results))
+		copy(out, results)
+		switch key {
+		case "path":
+			sort.SliceStable(out, func(i, j int) bool { return out[i].Path < out[j].Path })
+			return out, nil
+		case "grade", "ari", "fog", "ease", "words", "lines":
+			metric := func(r *analyzer.Result) float64 {
+				switch key {
+				case "ari":
+					return r.Metrics.ARI
+				case "fog":
+					return r.Metrics.GunningFog
+				case "ease":
+					return -r.Metrics.FleschEase // low ease is worst
+				case "words":
+					return float64(r.Metrics.Words)
+				case "lines":
+					return float64(r.Metrics.Lines)
+				}
+				return r.Metrics.FleschKincaid
+			}
+			sort.SliceStable(out, func(i, j int) bool { return metric(out[i]) > metric(out[j]) })
+			return out, nil
+		}
+		return nil, fmt.Errorf("sortBy: unknown key %q", key)
+	},
+	"pct": func(part, whole int) float64 {
+		if whole == 0 {
+			return 0
+		}
+		return float64(part) / float64(whole) * 100
+	},
+}
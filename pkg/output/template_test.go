@@ -0,0 +1,94 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/analyzer"
+)
+
+// writeTemplate writes a template file and parses it through NewTemplate.
+func writeTemplate(t *testing.T, text string) Template {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(path, []byte(text), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := NewTemplate(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tmpl
+}
+
+func templateFixture() []*analyzer.Result {
+	return []*analyzer.Result{
+		{Path: "easy.md", Status: analyzer.StatusPass,
+			Metrics: analyzer.Metrics{FleschKincaid: 6, FleschEase: 80}},
+		{Path: "hard.md", Status: analyzer.StatusFail,
+			Metrics: analyzer.Metrics{FleschKincaid: 15, FleschEase: 20}},
+		{Path: "medium.md", Status: analyzer.StatusPass,
+			Metrics: analyzer.Metrics{FleschKincaid: 10, FleschEase: 55}},
+	}
+}
+
+func TestTemplateHelpers(t *testing.T) {
+	tmpl := writeTemplate(t, strings.Join([]string{
+		`{{range sortBy "ease" .Results}}{{.Path}} {{end}}`,
+		`{{range filterStatus "fail" .Results}}{{.Path}}{{end}}`,
+		`{{printf "%.0f" (pct .Aggregate.Failed .Aggregate.Analyzed)}}%`,
+	}, "\n"))
+
+	var buf strings.Builder
+	if err := tmpl.Format(&buf, templateFixture()); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("output = %q, want 3 lines", buf.String())
+	}
+	// Worst-first for ease means lowest score first.
+	if got := strings.TrimSpace(lines[0]); got != "hard.md medium.md easy.md" {
+		t.Errorf("sortBy ease = %q, want hard.md medium.md easy.md", got)
+	}
+	if lines[1] != "hard.md" {
+		t.Errorf("filterStatus fail = %q, want hard.md", lines[1])
+	}
+	if lines[2] != "33%" {
+		t.Errorf("pct line = %q, want 33%%", lines[2])
+	}
+}
+
+func TestTemplateSortByPath(t *testing.T) {
+	tmpl := writeTemplate(t, `{{range sortBy "path" .Results}}{{.Path}} {{end}}`)
+	var buf strings.Builder
+	if err := tmpl.Format(&buf, templateFixture()); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.TrimSpace(buf.String()); got != "easy.md hard.md medium.md" {
+		t.Errorf("sortBy path = %q, want ascending paths", got)
+	}
+}
+
+func TestTemplateSortByUnknownKey(t *testing.T) {
+	tmpl := writeTemplate(t, `{{range sortBy "bogus" .Results}}{{.Path}}{{end}}`)
+	err := tmpl.Format(&strings.Builder{}, templateFixture())
+	if err == nil || !strings.Contains(err.Error(), `unknown key "bogus"`) {
+		t.Errorf("Format = %v, want unknown key error", err)
+	}
+}
+
+func TestNewTemplateErrors(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.tmpl")
+	if err := os.WriteFile(path, []byte(`{{range .Results}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewTemplate(path); err == nil || !strings.Contains(err.Error(), "broken.tmpl") {
+		t.Errorf("NewTemplate = %v, want parse error naming the file", err)
+	}
+	if _, err := NewTemplate(filepath.Join(t.TempDir(), "absent.tmpl")); err == nil {
+		t.Error("NewTemplate on a missing file succeeded")
+	}
+}
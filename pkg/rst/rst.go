@@ -0,0 +1,158 @@
+// Package rst extracts prose and headings from reStructuredText files
+// so the readability pipeline can treat them like markdown. It is a
+// line-based extractor, not a full docutils implementation: directives,
+// comments, and literal blocks are stripped, roles and inline literals
+// reduce to their text, and underline-style headings are recognized.
+package rst
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+// Document is the parsed representation of a reStructuredText file,
+// shaped like markdown.Document where the analyzer needs it.
+type Document struct {
+	Path   string
+	Source []byte
+
+	blocks    []markdown.Block
+	headings  []markdown.Heading
+	codeLines int
+}
+
+var (
+	directiveLine = regexp.MustCompile(`^\.\.\s+[\w-]+::`)
+	rolePattern   = regexp.MustCompile("(?::[\\w:+-]+:)?`([^`]*)`(?:__?)?")
+	literalSpan   = regexp.MustCompile("``([^`]*)``")
+	bulletLine    = regexp.MustCompile(`^\s*[-*+]\s+`)
+)
+
+// isUnderline reports whether a line is a heading adornment: at least
+// three repeats of a single section punctuation character.
+func isUnderline(s string) bool {
+	if len(s) < 3 || !strings.ContainsRune(`=-~^"'#*+.`, rune(s[0])) {
+		return false
+	}
+	for i := 1; i < len(s); i++ {
+		if s[i] != s[0] {
+			return false
+		}
+	}
+	return true
+}
+
+// Parse extracts blocks and headings from src.
+func Parse(path string, src []byte) (*Document, error) {
+	d := &Document{Path: path, Source: src}
+
+	lines := strings.Split(string(src), "\n")
+	levels := map[byte]int{}
+	slugger := markdown.NewSlugger()
+	var para []string
+
+	flush := func(kind markdown.BlockKind) {
+		if len(para) == 0 {
+			return
+		}
+		text := inlineText(strings.Join(para, " "))
+		if text != "" {
+			d.blocks = append(d.blocks, markdown.Block{Kind: kind, Text: text})
+		}
+		para = nil
+	}
+
+	skipIndent := -1 // inside a directive or literal block when >= 0
+	literal := false
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+
+		if skipIndent >= 0 {
+			if trimmed == "" {
+				continue
+			}
+			if indent > skipIndent {
+				if literal {
+					d.codeLines++
+				}
+				continue
+			}
+			skipIndent = -1
+			literal = false
+		}
+
+		if trimmed == "" {
+			flush(markdown.BlockParagraph)
+			continue
+		}
+
+		// Directives and comments: ".. name:: args" or ".. comment".
+		if strings.HasPrefix(trimmed, ".. ") || trimmed == ".." {
+			flush(markdown.BlockParagraph)
+			skipIndent = indent
+			literal = directiveLine.MatchString(trimmed) &&
+				(strings.Contains(trimmed, "code") || strings.Contains(trimmed, "literal"))
+			continue
+		}
+
+		// Underline headings: text line followed by ===, ---, etc.
+		if len(para) > 0 && isUnderline(trimmed) && len(trimmed) >= len(para[len(para)-1]) {
+			text := inlineText(strings.Join(para, " "))
+			para = nil
+			ch := trimmed[0]
+			if _, ok := levels[ch]; !ok {
+				levels[ch] = len(levels) + 1
+			}
+			d.headings = append(d.headings, markdown.Heading{
+				Level: levels[ch],
+				Text:  text,
+				Slug:  slugger.Slug(text),
+			})
+			d.blocks = append(d.blocks, markdown.Block{Kind: markdown.BlockHeading, Text: text})
+			continue
+		}
+
+		// Literal block introduced by a trailing "::".
+		if strings.HasSuffix(trimmed, "::") {
+			para = append(para, strings.TrimSuffix(trimmed, "::")+".")
+			flush(markdown.BlockParagraph)
+			skipIndent = indent
+			literal = true
+			continue
+		}
+
+		if bulletLine.MatchString(line) {
+			flush(markdown.BlockParagraph)
+			para = append(para, bulletLine.ReplaceAllString(line, ""))
+			flush(markdown.BlockListItem)
+			continue
+		}
+
+		para = append(para, trimmed)
+	}
+	flush(markdown.BlockParagraph)
+	return d, nil
+}
+
+// inlineText strips roles and inline literals down to their text.
+func inlineText(s string) string {
+	s = literalSpan.ReplaceAllString(s, "$1")
+	s = rolePattern.ReplaceAllString(s, "$1")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Bytes returns the raw file content.
+func (d *Document) Bytes() []byte { return d.Source }
+
+// Blocks returns the document's prose blocks in order.
+func (d *Document) Blocks() []markdown.Block { return d.blocks }
+
+// Headings returns the document's headings in order.
+func (d *Document) Headings() []markdown.Heading { return d.headings }
+
+// CodeLines counts the lines inside literal and code blocks.
+func (d *Document) CodeLines() int { return d.codeLines }
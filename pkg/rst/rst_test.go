@@ -0,0 +1,71 @@
+package rst
+
+import (
+	"testing"
+
+	"github.com/adaptive-enforcement-lab/adaptive-enforcement-lab-com/pkg/markdown"
+)
+
+const sample = `Page Title
+==========
+
+Intro paragraph with a :ref:` + "`role <target>`" + ` and ` + "``inline literal``" + `.
+
+Section One
+-----------
+
+.. note::
+   Directive content is stripped.
+
+Example usage::
+
+    code line one
+    code line two
+
+- first item
+- second item
+`
+
+func TestParse(t *testing.T) {
+	doc, err := Parse("sample.rst", []byte(sample))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hs := doc.Headings()
+	if len(hs) != 2 {
+		t.Fatalf("got %d headings, want 2: %+v", len(hs), hs)
+	}
+	if hs[0].Level != 1 || hs[0].Text != "Page Title" || hs[0].Slug != "page-title" {
+		t.Errorf("heading 0 = %+v", hs[0])
+	}
+	if hs[1].Level != 2 || hs[1].Text != "Section One" {
+		t.Errorf("heading 1 = %+v", hs[1])
+	}
+
+	if doc.CodeLines() != 2 {
+		t.Errorf("CodeLines = %d, want 2", doc.CodeLines())
+	}
+
+	var para, items int
+	for _, b := range doc.Blocks() {
+		switch b.Kind {
+		case markdown.BlockParagraph:
+			para++
+			if b.Text == "Directive content is stripped." {
+				t.Error("directive content leaked into prose")
+			}
+		case markdown.BlockListItem:
+			items++
+		}
+	}
+	if items != 2 {
+		t.Errorf("got %d list items, want 2", items)
+	}
+	for _, b := range doc.Blocks() {
+		if b.Text == "Intro paragraph with a role <target> and inline literal." {
+			return
+		}
+	}
+	t.Errorf("intro paragraph not extracted cleanly: %+v", doc.Blocks())
+}
@@ -0,0 +1,161 @@
+// Package spell flags unknown words in markdown prose against an
+// embedded English word list plus an optional project dictionary.
+package spell
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// DictFile is the project dictionary filename: one technical term per
+// line, comments starting with #.
+const DictFile = ".content-analyzer-dict.txt"
+
+//go:embed words.txt.gz
+var embeddedWords []byte
+
+// Misspelling is one unknown word and the line it appears on.
+type Misspelling struct {
+	Word string `json:"word"`
+	Line int    `json:"line"`
+}
+
+// Checker holds the dictionary, loaded once per run.
+type Checker struct {
+	words map[string]struct{}
+}
+
+// NewChecker loads the embedded word list and any dictionary files
+// given. Missing dictionary files are not an error, so callers can pass
+// DictFile unconditionally.
+func NewChecker(dictPaths ...string) (*Checker, error) {
+	c := &Checker{words: make(map[string]struct{}, 400000)}
+
+	zr, err := gzip.NewReader(bytes.NewReader(embeddedWords))
+	if err != nil {
+		return nil, fmt.Errorf("spell: embedded word list: %w", err)
+	}
+	if err := c.addFrom(zr); err != nil {
+		return nil, fmt.Errorf("spell: embedded word list: %w", err)
+	}
+
+	for _, path := range dictPaths {
+		f, err := os.Open(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("spell: %w", err)
+		}
+		err = c.addFrom(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("spell: %s: %w", path, err)
+		}
+	}
+	return c, nil
+}
+
+func (c *Checker) addFrom(r io.Reader) error {
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		word := strings.TrimSpace(sc.Text())
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+		c.words[strings.ToLower(word)] = struct{}{}
+	}
+	return sc.Err()
+}
+
+// Known reports whether a single word is in the dictionary.
+// Contractions are checked by their stem, so "isn't" and "we'll" do not
+// need dictionary entries.
+func (c *Checker) Known(word string) bool {
+	word = strings.ToLower(word)
+	if _, ok := c.words[word]; ok {
+		return true
+	}
+	for _, suffix := range []string{"n't", "'s", "'re", "'ll", "'ve", "'d", "'t", "'m"} {
+		if stem, ok := strings.CutSuffix(word, suffix); ok {
+			_, known := c.words[stem]
+			return known
+		}
+	}
+	return false
+}
+
+var (
+	inlineCode = regexp.MustCompile("`[^`]*`")
+	urlPattern = regexp.MustCompile(`\bhttps?://\S+|\bwww\.\S+`)
+	wordRune   = regexp.MustCompile(`[A-Za-z']+`)
+)
+
+// Check scans markdown source and returns unknown words with line
+// numbers. Frontmatter, code blocks, inline code spans, and URLs are
+// skipped, as are identifier-like tokens: anything with digits,
+// internal capitals, or underscores is assumed intentional.
+func (c *Checker) Check(src []byte) []Misspelling {
+	var out []Misspelling
+	inFence := false
+	inFrontmatter := false
+	for i, line := range strings.Split(string(src), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if i == 0 && trimmed == "---" {
+			inFrontmatter = true
+			continue
+		}
+		if inFrontmatter {
+			if trimmed == "---" {
+				inFrontmatter = false
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			continue
+		}
+
+		clean := urlPattern.ReplaceAllString(inlineCode.ReplaceAllString(line, " "), " ")
+		for _, token := range strings.Fields(clean) {
+			if strings.ContainsAny(token, "0123456789_") {
+				continue
+			}
+			for _, word := range wordRune.FindAllString(token, -1) {
+				if !checkable(word) {
+					continue
+				}
+				if !c.Known(strings.Trim(word, "'")) {
+					out = append(out, Misspelling{Word: word, Line: i + 1})
+				}
+			}
+		}
+	}
+	return out
+}
+
+// checkable filters out tokens a dictionary cannot judge: short words,
+// acronyms, and CamelCase identifiers.
+func checkable(word string) bool {
+	word = strings.Trim(word, "'")
+	if len(word) <= 2 {
+		return false
+	}
+	for i, r := range word {
+		if i > 0 && unicode.IsUpper(r) {
+			return false // CamelCase, ALLCAPS, or mixed-case identifier
+		}
+	}
+	return true
+}
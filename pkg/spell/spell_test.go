@@ -0,0 +1,42 @@
+package spell
+
+import "testing"
+
+func TestCheck(t *testing.T) {
+	c, err := NewChecker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := []byte(`---
+misspeled: frontmatter ignored
+---
+# Title
+
+Teh pipeline recieves artifacts.
+
+Inline ` + "`codewrd`" + ` and https://example.com/wrd are skipped.
+
+` + "```\nfenced codewrds ignored\n```" + `
+`)
+	got := c.Check(src)
+	want := map[string]int{"Teh": 6, "recieves": 6}
+	if len(got) != len(want) {
+		t.Fatalf("Check = %+v, want %d misspellings", got, len(want))
+	}
+	for _, m := range got {
+		if line, ok := want[m.Word]; !ok || line != m.Line {
+			t.Errorf("unexpected misspelling %+v", m)
+		}
+	}
+}
+
+func TestCheckableSkipsIdentifiers(t *testing.T) {
+	for _, word := range []string{"CamelCase", "ALLCAPS", "ab"} {
+		if checkable(word) {
+			t.Errorf("checkable(%q) = true, want false", word)
+		}
+	}
+	if !checkable("ordinary") {
+		t.Error("checkable(\"ordinary\") = false, want true")
+	}
+}